@@ -58,6 +58,36 @@ func (c *RHServiceClient) DemitirColaborador(deptNome string, colabID int) (Shar
 	return resp, err
 }
 
+func (c *RHServiceClient) AtualizarColaborador(deptNome string, colabID int, atualizacao Models.AtualizacaoColaborador) (Shared.Response, error) {
+	reqData := Shared.AtualizarColaboradorRequestData{
+		DepartamentoNome: deptNome,
+		ColaboradorID:    colabID,
+		Atualizacao:      atualizacao,
+	}
+	req := Shared.Request{Operation: Shared.OpAtualizarColaborador, Data: reqData}
+	if err := c.encoder.Encode(req); err != nil {
+		return Shared.Response{}, err
+	}
+	var resp Shared.Response
+	err := c.decoder.Decode(&resp)
+	return resp, err
+}
+
+func (c *RHServiceClient) TransferirColaborador(origemNome, destinoNome string, colabID int) (Shared.Response, error) {
+	reqData := Shared.TransferirColaboradorRequestData{
+		DepartamentoOrigem:  origemNome,
+		DepartamentoDestino: destinoNome,
+		ColaboradorID:       colabID,
+	}
+	req := Shared.Request{Operation: Shared.OpTransferirColaborador, Data: reqData}
+	if err := c.encoder.Encode(req); err != nil {
+		return Shared.Response{}, err
+	}
+	var resp Shared.Response
+	err := c.decoder.Decode(&resp)
+	return resp, err
+}
+
 func (c *RHServiceClient) CalcularFolhaSalarial(deptNome string) (Shared.Response, error) {
 	reqData := Shared.DepartamentoRequestData{DepartamentoNome: deptNome}
 	req := Shared.Request{Operation: Shared.OpCalcularFolha, Data: reqData}
@@ -104,6 +134,21 @@ func main() {
 	respAdd, err = client.AdicionarColaborador("TI", colabAutonomo)
 	fmt.Printf("Adicionar Autonomo (TI): Success: %t, Msg: %s, Err: %v\n", respAdd.Success, respAdd.Message, err)
 
+	// O ID 201 já pertence a TI, então o servidor recusa o mesmo ID em outro departamento.
+	respAdd, err = client.AdicionarColaborador("RH", colabEfetivo)
+	fmt.Printf("Adicionar ID repetido (RH): Success: %t, Msg: %s, Err: %v\n", respAdd.Success, respAdd.Message, err)
+
+	// Sem ID, o servidor aloca um e devolve o colaborador gravado.
+	colabSemID := Models.Estagiario{
+		ColaboradorBase: Models.ColaboradorBase{Nome: "Paula Reis"},
+		AuxilioEstagio:  1300.00,
+	}
+	respAdd, err = client.AdicionarColaborador("RH", colabSemID)
+	fmt.Printf("Adicionar sem ID (RH): Success: %t, Msg: %s, Err: %v\n", respAdd.Success, respAdd.Message, err)
+	if colab, ok := respAdd.Data.(Models.Colaborador); ok {
+		fmt.Printf("  ID alocado: %d, Nome: %s\n", colab.GetId(), colab.GetNome())
+	}
+
 	respListTI, err := client.ListarColaboradores("TI")
 	fmt.Printf("Listar (TI): Success: %t, Msg: %s, Err: %v\n", respListTI.Success, respListTI.Message, err)
 	if respListTI.Success && respListTI.Data != nil {
@@ -115,14 +160,25 @@ func main() {
 		}
 	}
 
+	novasHoras := 60
+	respAtualizar, err := client.AtualizarColaborador("TI", 202, Models.AtualizacaoColaborador{HorasTrabalhadas: &novasHoras})
+	fmt.Printf("Atualizar horas (ID 202 de TI): Success: %t, Msg: %s, Err: %v\n", respAtualizar.Success, respAtualizar.Message, err)
+	if colab, ok := respAtualizar.Data.(Models.Colaborador); ok {
+		fmt.Printf("  ID: %d, Nome: %s, Salario: %.2f\n", colab.GetId(), colab.GetNome(), colab.CalcularSalario())
+	}
+
 	respFolhaTI, err := client.CalcularFolhaSalarial("TI")
 	fmt.Printf("Calcular Folha (TI): Success: %t, Msg: %s, Err: %v\n", respFolhaTI.Success, respFolhaTI.Message, err)
 	if respFolhaTI.Success {
-		if total, ok := respFolhaTI.Data.(float64); ok {
-			fmt.Printf("Total da folha salarial de TI: %.2f\n", total)
+		if resumo, ok := respFolhaTI.Data.(Models.ResumoFolha); ok {
+			fmt.Printf("Folha de TI: bruto %.2f, descontos %.2f, líquido %.2f, custo do empregador %.2f\n",
+				resumo.TotalBruto, resumo.TotalDescontos, resumo.TotalLiquido, resumo.CustoEmpregador)
 		}
 	}
 
+	respTransferir, err := client.TransferirColaborador("TI", "RH", 202)
+	fmt.Printf("Transferir (ID 202 de TI para RH): Success: %t, Msg: %s, Err: %v\n", respTransferir.Success, respTransferir.Message, err)
+
 	respDemitir, err := client.DemitirColaborador("TI", 201)
 	fmt.Printf("Demitir (ID 201 de TI): Success: %t, Msg: %s, Err: %v\n", respDemitir.Success, respDemitir.Message, err)
 
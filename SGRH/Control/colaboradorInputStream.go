@@ -2,122 +2,180 @@ package Control
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"meu_rh/Models"
-	"strconv"
 	"strings"
 )
 
+// ColaboradorInputStream lê colaboradores de um stream. Os registros podem ser lidos
+// todos de uma vez com LerTodosOsDados ou um a um, à medida que chegam, com Next ou Todos.
 type ColaboradorInputStream struct {
-	Origem  io.Reader
-	scanner *bufio.Scanner
+	Origem io.Reader
+	// Formato esperado na entrada; com o valor zero, o formato é detectado pelo início do
+	// stream. A compressão, se houver, é sempre detectada (ver Compressao).
+	Formato Formato
+	// Tolerante faz a leitura pular registros malformados em vez de abortar. As linhas
+	// puladas ficam disponíveis em LinhasIgnoradas. Erros de estrutura do stream
+	// (cabeçalho, separadores, fim inesperado) continuam interrompendo a leitura.
+	Tolerante bool
+	leitor    *bufio.Reader
+	dec       decodificador
+	atual     Models.Colaborador
+	err       error // Primeiro erro que encerrou a leitura; io.EOF no fim normal
+	ignoradas []ErroLinha
+}
+
+// ErroLinha descreve um registro malformado e a linha do stream em que ele está.
+type ErroLinha struct {
+	Linha    int
+	Conteudo string
+	Err      error
+}
+
+func (e *ErroLinha) Error() string {
+	return fmt.Sprintf("linha %d: %v", e.Linha, e.Err)
+}
+
+func (e *ErroLinha) Unwrap() error {
+	return e.Err
 }
 
 func NewColaboradorInputStream(origem io.Reader) *ColaboradorInputStream {
 	return &ColaboradorInputStream{
-		Origem:  origem,
-		scanner: bufio.NewScanner(origem),
+		Origem: origem,
+		leitor: bufio.NewReader(origem),
 	}
 }
 
 func (cis *ColaboradorInputStream) LerTodosOsDados() ([]Models.Colaborador, error) {
 	var colaboradores []Models.Colaborador
-
-	if !cis.scanner.Scan() {
-		if err := cis.scanner.Err(); err != nil {
-			return nil, fmt.Errorf("falha ao ler cabecalho do stream: %w", err)
-		}
-		return nil, fmt.Errorf("stream vazio ou formato de cabecalho inesperado")
+	for cis.Next() {
+		colaboradores = append(colaboradores, cis.Colaborador())
 	}
-	headerLine := cis.scanner.Text()
-
-	var numObjetos int
-	_, err := fmt.Sscanf(headerLine, "Enviando dados de %d objetos...", &numObjetos)
-	if err != nil {
-		return nil, fmt.Errorf("formato de cabecalho invalido '%s': %w", headerLine, err)
+	if err := cis.Err(); err != nil {
+		return nil, err
 	}
+	return colaboradores, nil
+}
 
-	if !cis.scanner.Scan() || cis.scanner.Text() != "---" {
-		if errSc := cis.scanner.Err(); errSc != nil {
-			return nil, fmt.Errorf("falha ao ler separador '---' pos-cabecalho: %w", errSc)
+// Next avança para o próximo colaborador, bloqueando até que ele chegue por completo.
+// Devolve false no fim do stream ou em caso de erro; Err diferencia os dois casos.
+func (cis *ColaboradorInputStream) Next() bool {
+	for {
+		colab, err := cis.avancar()
+		if err == nil {
+			cis.atual = colab
+			return true
 		}
-
-		if numObjetos > 0 || (numObjetos == 0 && cis.scanner.Text() != "") { 
-		    return nil, fmt.Errorf("esperado '---' apos cabecalho, obteve: '%s'", cis.scanner.Text())
+		if cis.err != nil {
+			cis.atual = nil
+			return false
 		}
+		// Registro malformado pulado no modo tolerante.
 	}
+}
 
+// Colaborador devolve o colaborador lido pela última chamada de Next.
+func (cis *ColaboradorInputStream) Colaborador() Models.Colaborador {
+	return cis.atual
+}
 
-	if numObjetos == 0 {
-		return colaboradores, nil
+// Err devolve o erro que encerrou a leitura, ou nil se o stream terminou normalmente.
+func (cis *ColaboradorInputStream) Err() error {
+	if cis.err == io.EOF {
+		return nil
 	}
+	return cis.err
+}
 
-	for i := 0; i < numObjetos; i++ {
-		if !cis.scanner.Scan() {
-			if err := cis.scanner.Err(); err != nil {
-				return nil, fmt.Errorf("falha ao ler dados do colaborador %d: %w", i+1, err)
-			}
-			return nil, fmt.Errorf("fim inesperado do stream ao ler colaborador %d de %d", i+1, numObjetos)
-		}
-		line := cis.scanner.Text()
-
-		if !strings.HasPrefix(line, "|ID:") || !strings.HasSuffix(line, "|") {
-			return nil, fmt.Errorf("formato de linha de colaborador invalido para obj %d: '%s'", i+1, line)
-		}
-		content := strings.Trim(line, "|") 
-
-		idAndRest := strings.SplitN(content, ", Nome: ", 2)
-		if len(idAndRest) != 2 {
-			return nil, fmt.Errorf("formato de dados de colaborador (esperado 'ID: ..., Nome: ...') invalido na linha '%s' para obj %d", line, i+1)
-		}
-		idStrPart := strings.TrimPrefix(idAndRest[0], "ID: ")
-
-		nomeAndSalario := idAndRest[1] 
-		
-		nomeSalarioParts := strings.SplitN(nomeAndSalario, " Salario: ", 2)
-		if len(nomeSalarioParts) != 2 {
-			return nil, fmt.Errorf("formato de dados de colaborador (esperado 'Nome Salario: ...') invalido na linha '%s' para obj %d", line, i+1)
-		}
-		nomePart := nomeSalarioParts[0]
-		salarioStrPart := nomeSalarioParts[1]
-		// FIM DA NOVA LÓGICA DE PARSING
+// LinhasIgnoradas devolve os registros pulados até aqui no modo tolerante.
+func (cis *ColaboradorInputStream) LinhasIgnoradas() []ErroLinha {
+	return cis.ignoradas
+}
 
-		id, err := strconv.Atoi(idStrPart)
-		if err != nil {
-			return nil, fmt.Errorf("falha ao converter ID '%s' para obj %d: %w", idStrPart, i+1, err)
+// Todos percorre o stream com range. Cada registro válido vem com erro nil; no modo
+// tolerante, registros malformados vêm como (nil, *ErroLinha) e a iteração continua.
+// Qualquer outro erro é entregue uma única vez e encerra a iteração.
+func (cis *ColaboradorInputStream) Todos() iter.Seq2[Models.Colaborador, error] {
+	return func(yield func(Models.Colaborador, error) bool) {
+		for {
+			colab, err := cis.avancar()
+			if err == io.EOF {
+				return
+			}
+			if !yield(colab, err) || cis.err != nil {
+				return
+			}
 		}
+	}
+}
 
-		salario, err := strconv.ParseFloat(salarioStrPart, 64)
+// avancar lê o próximo registro. Erros que encerram a leitura ficam guardados em
+// cis.err e são devolvidos de novo nas chamadas seguintes.
+func (cis *ColaboradorInputStream) avancar() (Models.Colaborador, error) {
+	if cis.err != nil {
+		return nil, cis.err
+	}
+	if cis.dec == nil {
+		dec, err := cis.novoDecodificador()
 		if err != nil {
-			return nil, fmt.Errorf("falha ao converter Salario '%s' para obj %d: %w", salarioStrPart, i+1, err)
-		}
-
-		colab := Models.StreamedColaborador{
-			ColaboradorBase:  Models.ColaboradorBase{Id: id, Nome: nomePart},
-			SalarioCalculado: salario,
+			cis.err = err
+			return nil, err
 		}
-		colaboradores = append(colaboradores, colab)
+		cis.dec = dec
+	}
 
-		// Ler o "---" do footer repetitivo
-		if !cis.scanner.Scan() || cis.scanner.Text() != "---" {
-			if err := cis.scanner.Err(); err != nil {
-				return nil, fmt.Errorf("falha ao ler '---' do footer do colaborador %d: %w", i+1, err)
-			}
-			return nil, fmt.Errorf("esperado '---' no footer do colaborador %d de %d, obteve: '%s'", i+1, numObjetos, cis.scanner.Text())
-		}
-		// Ler o "Envio concluído." do footer repetitivo
-		if !cis.scanner.Scan() || !strings.Contains(cis.scanner.Text(), "Envio concluído.") {
-			if err := cis.scanner.Err(); err != nil {
-				return nil, fmt.Errorf("falha ao ler 'Envio concluído.' do footer do colaborador %d: %w", i+1, err)
-			}
-			return nil, fmt.Errorf("esperado 'Envio concluído.' no footer do colaborador %d de %d, obteve: '%s'", i+1, numObjetos, cis.scanner.Text())
+	colab, err := cis.dec.proximo()
+	if err != nil {
+		var erroLinha *ErroLinha
+		if cis.Tolerante && errors.As(err, &erroLinha) {
+			cis.ignoradas = append(cis.ignoradas, *erroLinha)
+		} else {
+			cis.err = err
 		}
+		return nil, err
 	}
+	return colab, nil
+}
 
-	if err := cis.scanner.Err(); err != nil {
-		return colaboradores, fmt.Errorf("erro de scanner ao final da leitura: %w", err)
+func (cis *ColaboradorInputStream) novoDecodificador() (decodificador, error) {
+	if err := cis.descomprimir(); err != nil {
+		return nil, err
+	}
+	formato := cis.Formato
+	if formato == "" {
+		formato = cis.detectarFormato()
+	}
+	formato, err := formatoOuPadrao(formato)
+	if err != nil {
+		return nil, err
 	}
+	linhas := &leitorLinhas{r: cis.leitor}
+	switch formato {
+	case FormatoCSV:
+		return newDecodificadorCSV(cis.leitor), nil
+	case FormatoNDJSON:
+		return &decodificadorNDJSON{linhas: linhas}, nil
+	case FormatoV2, FormatoEnquadrado:
+		return novoDecodificadorV2(linhas, formato)
+	}
+	return &decodificadorLegado{linhas: linhas}, nil
+}
 
-	return colaboradores, nil
-}
\ No newline at end of file
+// detectarFormato olha o início do stream sem consumi-lo. Na dúvida, assume o formato
+// legado, cujo parser produz a mensagem de erro mais útil para entradas antigas.
+func (cis *ColaboradorInputStream) detectarFormato() Formato {
+	inicio, _ := cis.leitor.Peek(len(prefixoVersao))
+	switch {
+	case strings.HasPrefix(string(inicio), prefixoVersao):
+		return FormatoV2
+	case strings.HasPrefix(string(inicio), "{"):
+		return FormatoNDJSON
+	case strings.HasPrefix(string(inicio), strings.Join(cabecalhoCSV[:2], ",")):
+		return FormatoCSV
+	}
+	return FormatoLegado
+}
@@ -1,15 +1,25 @@
 package Control
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
+	"sync/atomic"
 	"meu_rh/Models"
 )
 
 type ColaboradorOuputStream struct {
 	Destino       io.Writer
 	Colaboradores []Models.Colaborador
+	// Formato da saída; o valor zero equivale a FormatoLegado.
+	Formato Formato
+	// Compressao aplicada ao stream inteiro; o valor zero escreve o texto sem compressão.
+	Compressao Compressao
+	// confirmados é o contador do envio enquadrado mais recente; cada chamada de
+	// EnviarEnquadrado cria o seu, que só as confirmações daquela chamada atualizam.
+	confirmados atomic.Pointer[atomic.Int64]
 }
 
 func NewColaboradorOutputStream(destino io.Writer, dados []Models.Colaborador) *ColaboradorOuputStream {
@@ -20,6 +30,72 @@ func NewColaboradorOutputStream(destino io.Writer, dados []Models.Colaborador) *
 }
 
 func (cos *ColaboradorOuputStream) EscreverTodosOsDados() error {
+	if cos.Compressao != CompressaoNenhuma {
+		return cos.escreverComprimido()
+	}
+	formato, err := formatoOuPadrao(cos.Formato)
+	if err != nil {
+		return err
+	}
+	switch formato {
+	case FormatoCSV:
+		return cos.escreverCSV()
+	case FormatoNDJSON:
+		return cos.escreverNDJSON()
+	case FormatoV2:
+		return cos.escreverV2()
+	case FormatoEnquadrado:
+		if _, err := io.WriteString(cos.Destino, cabecalhoEnquadrado(len(cos.Colaboradores), "")); err != nil {
+			return fmt.Errorf("falha ao escrever cabecalho: %w", err)
+		}
+		return cos.escreverEnquadrado(cos.Destino, 1)
+	}
+	return cos.escreverLegado()
+}
+
+func (cos *ColaboradorOuputStream) escreverV2() error {
+	if _, err := io.WriteString(cos.Destino, cabecalhoV2(len(cos.Colaboradores))); err != nil {
+		return fmt.Errorf("falha ao escrever cabecalho: %w", err)
+	}
+	for _, colab := range cos.Colaboradores {
+		if _, err := io.WriteString(cos.Destino, novoRegistro(colab).paraLinhaV2()); err != nil {
+			return fmt.Errorf("falha ao escrever os dados do colaborador ID %d: %w", colab.GetId(), err)
+		}
+	}
+	if _, err := io.WriteString(cos.Destino, trailerV2+"\n"); err != nil {
+		return fmt.Errorf("falha ao escrever finalizacao: %w", err)
+	}
+	return nil
+}
+
+func (cos *ColaboradorOuputStream) escreverCSV() error {
+	w := csv.NewWriter(cos.Destino)
+	if err := w.Write(cabecalhoCSV); err != nil {
+		return fmt.Errorf("falha ao escrever cabecalho CSV: %w", err)
+	}
+	for _, colab := range cos.Colaboradores {
+		if err := w.Write(novoRegistro(colab).paraCSV()); err != nil {
+			return fmt.Errorf("falha ao escrever os dados do colaborador ID %d: %w", colab.GetId(), err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("falha ao finalizar CSV: %w", err)
+	}
+	return nil
+}
+
+func (cos *ColaboradorOuputStream) escreverNDJSON() error {
+	enc := json.NewEncoder(cos.Destino)
+	for _, colab := range cos.Colaboradores {
+		if err := enc.Encode(novoRegistro(colab)); err != nil {
+			return fmt.Errorf("falha ao escrever os dados do colaborador ID %d: %w", colab.GetId(), err)
+		}
+	}
+	return nil
+}
+
+func (cos *ColaboradorOuputStream) escreverLegado() error {
 	numObjetos := len(cos.Colaboradores)
 	cabecalho := fmt.Sprintf("Enviando dados de %d objetos...\n---\n", numObjetos)
 	_, err := cos.Destino.Write([]byte(cabecalho))
@@ -34,7 +110,7 @@ func (cos *ColaboradorOuputStream) EscreverTodosOsDados() error {
 
 	for _, colab := range cos.Colaboradores {
 		idStr := strconv.Itoa(colab.GetId())
-		nome := colab.GetNome()
+		nome := escaparNome(colab.GetNome(), "")
 		salarioStr := fmt.Sprintf("%.2f", colab.CalcularSalario())
 
 		linha := fmt.Sprintf("|ID: %s, Nome: %s Salario: %s|\n", idStr, nome, salarioStr)
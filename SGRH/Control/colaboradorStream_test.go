@@ -0,0 +1,373 @@
+package Control
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"meu_rh/Models"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// FuzzRoundTrip escreve um colaborador com EscreverTodosOsDados e confere que
+// LerTodosOsDados devolve o mesmo ID, nome e salário, qualquer que seja o nome.
+func FuzzRoundTrip(f *testing.F) {
+	for _, nome := range []string{
+		"",
+		"Ana",
+		"Ana | Salario: Jr",
+		"Beto; id=999",
+		"Caio\nSegunda Linha \\ fim",
+		"|ID: 1, Nome: x Salario: 2|",
+		"termina com barra\\",
+		"\r\n---\nEnvio concluído.\n",
+		"FIM",
+		"José Ñandú 日本",
+		"\xff\\\xfe;",
+	} {
+		f.Add(1, nome, 1234.5)
+	}
+
+	f.Fuzz(func(t *testing.T, id int, nome string, salario float64) {
+		original := Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: id, Nome: nome}, SalarioMensal: salario}
+		for _, formato := range []Formato{FormatoLegado, FormatoV2} {
+			var buf bytes.Buffer
+			saida := NewColaboradorOutputStream(&buf, []Models.Colaborador{original})
+			saida.Formato = formato
+			if err := saida.EscreverTodosOsDados(); err != nil {
+				t.Fatalf("%s: escrever: %v", formato, err)
+			}
+			lidos, err := NewColaboradorInputStream(&buf).LerTodosOsDados()
+			if err != nil {
+				t.Fatalf("%s: ler: %v", formato, err)
+			}
+			if len(lidos) != 1 {
+				t.Fatalf("%s: esperava 1 colaborador, li %d", formato, len(lidos))
+			}
+			lido := lidos[0]
+			if lido.GetId() != id {
+				t.Errorf("%s: ID %d, esperava %d", formato, lido.GetId(), id)
+			}
+			if lido.GetNome() != nome {
+				t.Errorf("%s: nome %q, esperava %q", formato, lido.GetNome(), nome)
+			}
+			// O formato legado grava o salário com duas casas; o v2 o grava por completo.
+			esperado, obtido := strconv.FormatFloat(salario, 'g', -1, 64), strconv.FormatFloat(lido.CalcularSalario(), 'g', -1, 64)
+			if formato == FormatoLegado {
+				esperado, obtido = fmt.Sprintf("%.2f", salario), fmt.Sprintf("%.2f", lido.CalcularSalario())
+			}
+			if obtido != esperado {
+				t.Errorf("%s: salario %s, esperava %s", formato, obtido, esperado)
+			}
+		}
+	})
+}
+
+const colaboradoresBenchmark = 20000
+
+// loteBenchmark mistura os três tipos de colaborador, com nomes e valores variados.
+var loteBenchmark = sync.OnceValue(func() []Models.Colaborador {
+	colaboradores := make([]Models.Colaborador, 0, colaboradoresBenchmark)
+	for i := 1; i <= colaboradoresBenchmark; i++ {
+		base := Models.ColaboradorBase{Id: 10000 + i, Nome: fmt.Sprintf("Colaborador %05d da Silva", i)}
+		switch i % 3 {
+		case 0:
+			colaboradores = append(colaboradores, Models.Efetivo{ColaboradorBase: base, SalarioMensal: 3000 + float64(i%50)*100})
+		case 1:
+			colaboradores = append(colaboradores, Models.Autonomo{ColaboradorBase: base, ValorHora: 80 + float64(i%20), HorasTrabalhadas: 40 + i%80})
+		default:
+			colaboradores = append(colaboradores, Models.Estagiario{ColaboradorBase: base, AuxilioEstagio: 1000 + float64(i%10)*50})
+		}
+	}
+	return colaboradores
+})
+
+// O formato legado fica de fora: ele imprime uma linha por colaborador na saída padrão.
+var formatosBenchmark = []Formato{FormatoCSV, FormatoNDJSON, FormatoV2, FormatoEnquadrado}
+
+// executarBenchmarks roda fn num sub-benchmark "<formato>/<compressao>" para cada par,
+// com o lote já codificado naquela combinação.
+func executarBenchmarks(b *testing.B, fn func(b *testing.B, saida *ColaboradorOuputStream, codificado []byte)) {
+	for _, formato := range formatosBenchmark {
+		for _, compressao := range []Compressao{CompressaoNenhuma, CompressaoGzip} {
+			nomeCompressao := string(compressao)
+			if nomeCompressao == "" {
+				nomeCompressao = "nenhuma"
+			}
+			b.Run(string(formato)+"/"+nomeCompressao, func(b *testing.B) {
+				var buf bytes.Buffer
+				saida := NewColaboradorOutputStream(&buf, loteBenchmark())
+				saida.Formato = formato
+				saida.Compressao = compressao
+				if err := saida.EscreverTodosOsDados(); err != nil {
+					b.Fatal(err)
+				}
+				codificado := buf.Bytes()
+				b.SetBytes(int64(len(codificado)))
+				fn(b, saida, codificado)
+				b.ReportMetric(float64(len(codificado)), "bytes-codificados")
+			})
+		}
+	}
+}
+
+func BenchmarkEscrever(b *testing.B) {
+	executarBenchmarks(b, func(b *testing.B, saida *ColaboradorOuputStream, codificado []byte) {
+		var buf bytes.Buffer
+		buf.Grow(len(codificado))
+		saida.Destino = &buf
+		for b.Loop() {
+			buf.Reset()
+			if err := saida.EscreverTodosOsDados(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkLer(b *testing.B) {
+	executarBenchmarks(b, func(b *testing.B, _ *ColaboradorOuputStream, codificado []byte) {
+		for b.Loop() {
+			lidos, err := NewColaboradorInputStream(bytes.NewReader(codificado)).LerTodosOsDados()
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(lidos) != colaboradoresBenchmark {
+				b.Fatalf("lidos %d de %d colaboradores", len(lidos), colaboradoresBenchmark)
+			}
+		}
+	})
+}
+
+func colaboradoresEnquadrados(n int) []Models.Colaborador {
+	colaboradores := make([]Models.Colaborador, n)
+	for i := range colaboradores {
+		colaboradores[i] = Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: i + 1, Nome: fmt.Sprintf("Colab %d", i+1)}, SalarioMensal: 1000}
+	}
+	return colaboradores
+}
+
+type recepcao struct {
+	lote          string
+	colaboradores []Models.Colaborador
+	err           error
+}
+
+// receberEmParalelo atende uma conexão com o receptor e devolve a ponta do emissor.
+func receberEmParalelo(t *testing.T, receptor *ReceptorEnquadrado) (net.Conn, <-chan recepcao) {
+	t.Helper()
+	emissor, conexao := net.Pipe()
+	t.Cleanup(func() { emissor.Close() })
+	resultado := make(chan recepcao, 1)
+	go func() {
+		lote, colaboradores, err := receptor.Receber(conexao)
+		conexao.Close()
+		resultado <- recepcao{lote, colaboradores, err}
+	}()
+	return emissor, resultado
+}
+
+// emissorManual conduz o diálogo enquadrado linha a linha, para que os testes possam
+// corromper quadros e derrubar a conexão em pontos exatos.
+type emissorManual struct {
+	t         *testing.T
+	conn      net.Conn
+	respostas *bufio.Reader
+	resumo    []byte
+}
+
+func novoEmissorManual(t *testing.T, conn net.Conn, lote string, total int) *emissorManual {
+	e := &emissorManual{t: t, conn: conn, respostas: bufio.NewReader(conn)}
+	e.enviar(cabecalhoEnquadrado(total, lote))
+	return e
+}
+
+func (e *emissorManual) enviar(linha string) {
+	e.t.Helper()
+	if _, err := e.conn.Write([]byte(linha)); err != nil {
+		e.t.Fatalf("enviar %q: %v", linha, err)
+	}
+}
+
+func (e *emissorManual) esperar(resposta string) {
+	e.t.Helper()
+	linha, err := e.respostas.ReadString('\n')
+	if err != nil {
+		e.t.Fatalf("esperava %q, conexão falhou: %v", resposta, err)
+	}
+	if linha = strings.TrimSuffix(linha, "\n"); !strings.HasPrefix(linha, resposta) {
+		e.t.Fatalf("esperava %q, obteve %q", resposta, linha)
+	}
+}
+
+// quadro envia o registro seq e espera o ACK; crc vazio usa o CRC correto.
+func (e *emissorManual) quadro(seq int, c Models.Colaborador, crc string) {
+	e.t.Helper()
+	linha := linhaEnquadrada(seq, strings.TrimSuffix(novoRegistro(c).paraLinhaV2(), "\n"))
+	if crc != "" {
+		linha = fmt.Sprintf("%d %s %s", seq, crc, strings.SplitN(linha, " ", 3)[2])
+	}
+	e.resumo = append(e.resumo, linha...)
+	e.enviar(linha)
+	if crc == "" {
+		e.esperar(fmt.Sprintf("%s %d", msgAck, seq))
+	}
+}
+
+func (e *emissorManual) fim() {
+	e.enviar(fmt.Sprintf("%s sha256=%x\n", trailerV2, sha256.Sum256(e.resumo)))
+}
+
+func TestEnquadradoRetomaDepoisDeQueda(t *testing.T) {
+	receptor := NewReceptorEnquadrado()
+	colaboradores := colaboradoresEnquadrados(5)
+
+	conn, resultado := receberEmParalelo(t, receptor)
+	emissor := novoEmissorManual(t, conn, "lote-1", len(colaboradores))
+	emissor.esperar(msgRetomar + " 0")
+	emissor.quadro(1, colaboradores[0], "")
+	emissor.quadro(2, colaboradores[1], "")
+	conn.Close()
+	if r := <-resultado; r.err == nil {
+		t.Fatal("Receber não falhou com a conexão derrubada")
+	}
+	if n := receptor.Recebidos("lote-1"); n != 2 {
+		t.Fatalf("Recebidos = %d depois da queda, esperava 2", n)
+	}
+
+	conn, resultado = receberEmParalelo(t, receptor)
+	saida := NewColaboradorOutputStream(nil, colaboradores)
+	if err := saida.EnviarEnquadrado(conn, "lote-1"); err != nil {
+		t.Fatalf("EnviarEnquadrado: %v", err)
+	}
+	r := <-resultado
+	if r.err != nil {
+		t.Fatalf("Receber: %v", r.err)
+	}
+	if len(r.colaboradores) != len(colaboradores) {
+		t.Fatalf("recebidos %d colaboradores, esperava %d", len(r.colaboradores), len(colaboradores))
+	}
+	for i, c := range r.colaboradores {
+		if c.GetId() != i+1 {
+			t.Errorf("colaborador %d tem ID %d, esperava %d", i, c.GetId(), i+1)
+		}
+	}
+	if saida.Confirmados() != len(colaboradores) {
+		t.Errorf("Confirmados = %d, esperava %d", saida.Confirmados(), len(colaboradores))
+	}
+}
+
+func TestEnquadradoRecusaCRCDivergente(t *testing.T) {
+	receptor := NewReceptorEnquadrado()
+	colaboradores := colaboradoresEnquadrados(2)
+
+	conn, resultado := receberEmParalelo(t, receptor)
+	emissor := novoEmissorManual(t, conn, "lote-crc", len(colaboradores))
+	emissor.esperar(msgRetomar + " 0")
+	emissor.quadro(1, colaboradores[0], "")
+	emissor.quadro(2, colaboradores[1], "00000000")
+	emissor.esperar(msgErro)
+	if r := <-resultado; r.err == nil || !strings.Contains(r.err.Error(), "CRC32") {
+		t.Fatalf("Receber: err = %v, esperava erro de CRC32", r.err)
+	}
+	// O registro confirmado antes do quadro corrompido continua guardado.
+	if n := receptor.Recebidos("lote-crc"); n != 1 {
+		t.Errorf("Recebidos = %d, esperava 1", n)
+	}
+}
+
+func TestEnquadradoResumoDivergenteRecomecaOLote(t *testing.T) {
+	receptor := NewReceptorEnquadrado()
+	colaboradores := colaboradoresEnquadrados(2)
+
+	conn, resultado := receberEmParalelo(t, receptor)
+	emissor := novoEmissorManual(t, conn, "lote-sha", len(colaboradores))
+	emissor.esperar(msgRetomar + " 0")
+	emissor.quadro(1, colaboradores[0], "")
+	emissor.quadro(2, colaboradores[1], "")
+	emissor.resumo = append(emissor.resumo, "adulterado"...)
+	emissor.fim()
+	emissor.esperar(msgErro)
+	if r := <-resultado; !errors.Is(r.err, errResumoDivergente) {
+		t.Fatalf("Receber: err = %v, esperava errResumoDivergente", r.err)
+	}
+	if n := receptor.Recebidos("lote-sha"); n != 0 {
+		t.Errorf("Recebidos = %d depois do resumo divergente, esperava 0", n)
+	}
+
+	conn, resultado = receberEmParalelo(t, receptor)
+	emissor = novoEmissorManual(t, conn, "lote-sha", len(colaboradores))
+	emissor.esperar(msgRetomar + " 0")
+	emissor.quadro(1, colaboradores[0], "")
+	emissor.quadro(2, colaboradores[1], "")
+	emissor.fim()
+	emissor.esperar(fmt.Sprintf("%s %d", msgConcluido, len(colaboradores)))
+	if r := <-resultado; r.err != nil || len(r.colaboradores) != len(colaboradores) {
+		t.Fatalf("Receber: %d colaboradores, err = %v", len(r.colaboradores), r.err)
+	}
+}
+
+func TestEnquadradoReenvioDeLoteConcluido(t *testing.T) {
+	receptor := NewReceptorEnquadrado()
+	colaboradores := colaboradoresEnquadrados(3)
+	enviar := func() (*ColaboradorOuputStream, recepcao) {
+		t.Helper()
+		conn, resultado := receberEmParalelo(t, receptor)
+		saida := NewColaboradorOutputStream(nil, colaboradores)
+		if err := saida.EnviarEnquadrado(conn, "lote-dup"); err != nil {
+			t.Fatalf("EnviarEnquadrado: %v", err)
+		}
+		return saida, <-resultado
+	}
+
+	if _, r := enviar(); r.err != nil || len(r.colaboradores) != len(colaboradores) {
+		t.Fatalf("primeiro envio: %d colaboradores, err = %v", len(r.colaboradores), r.err)
+	}
+	// O CONCLUIDO se perdeu: o emissor reenvia, é confirmado e nada é entregue de novo.
+	saida, r := enviar()
+	if !errors.Is(r.err, ErrLoteJaRecebido) || r.colaboradores != nil {
+		t.Fatalf("reenvio: %d colaboradores, err = %v, esperava ErrLoteJaRecebido", len(r.colaboradores), r.err)
+	}
+	if saida.Confirmados() != len(colaboradores) {
+		t.Errorf("Confirmados no reenvio = %d, esperava %d", saida.Confirmados(), len(colaboradores))
+	}
+}
+
+func TestEnquadradoDescartaLotesConcluidosAposARetencao(t *testing.T) {
+	receptor := NewReceptorEnquadrado()
+	receptor.Retencao = time.Millisecond
+	colaboradores := colaboradoresEnquadrados(1)
+	enviar := func(lote string) recepcao {
+		t.Helper()
+		conn, resultado := receberEmParalelo(t, receptor)
+		if err := NewColaboradorOutputStream(nil, colaboradores).EnviarEnquadrado(conn, lote); err != nil {
+			t.Fatalf("EnviarEnquadrado(%s): %v", lote, err)
+		}
+		return <-resultado
+	}
+
+	if r := enviar("antigo"); r.err != nil {
+		t.Fatal(r.err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if r := enviar("novo"); r.err != nil {
+		t.Fatal(r.err)
+	}
+	receptor.mu.Lock()
+	_, antigo := receptor.lotes["antigo"]
+	_, novo := receptor.lotes["novo"]
+	receptor.mu.Unlock()
+	if antigo || !novo {
+		t.Fatalf("lotes guardados: antigo=%t novo=%t, esperava só o novo", antigo, novo)
+	}
+	// Depois de descartado, o mesmo nome é tratado como um lote novo.
+	if r := enviar("antigo"); r.err != nil || len(r.colaboradores) != 1 {
+		t.Fatalf("reenvio após a retenção: %d colaboradores, err = %v", len(r.colaboradores), r.err)
+	}
+}
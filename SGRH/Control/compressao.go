@@ -0,0 +1,89 @@
+package Control
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+)
+
+// Compressao seleciona a compressão aplicada a um stream inteiro, em qualquer formato.
+// O stream comprimido começa com a linha "SGRH-STREAM/2 compressao=<algoritmo>", em texto
+// puro, seguida do stream original comprimido. Na leitura a compressão é detectada por
+// essa linha ou, para arquivos .gz comuns, pelos bytes mágicos do gzip.
+type Compressao string
+
+const (
+	CompressaoNenhuma Compressao = ""
+	CompressaoGzip    Compressao = "gzip"
+)
+
+var magicoGzip = []byte{0x1f, 0x8b}
+
+func cabecalhoCompressao(c Compressao) string {
+	return fmt.Sprintf("%s%d compressao=%s\n", prefixoVersao, versaoAtual, c)
+}
+
+func (cos *ColaboradorOuputStream) escreverComprimido() error {
+	if cos.Compressao != CompressaoGzip {
+		return fmt.Errorf("compressao de stream desconhecida: '%s'", cos.Compressao)
+	}
+	if _, err := cos.Destino.Write([]byte(cabecalhoCompressao(cos.Compressao))); err != nil {
+		return fmt.Errorf("falha ao escrever cabecalho de compressao: %w", err)
+	}
+	gz := gzip.NewWriter(cos.Destino)
+	interno := NewColaboradorOutputStream(gz, cos.Colaboradores)
+	interno.Formato = cos.Formato
+	if err := interno.EscreverTodosOsDados(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("falha ao finalizar compressao: %w", err)
+	}
+	return nil
+}
+
+// descomprimir troca o leitor por um descompressor quando o stream está comprimido.
+// Cabeçalhos versionados sem compressão ficam intactos para o decodificador v2.
+func (cis *ColaboradorInputStream) descomprimir() error {
+	inicio, _ := cis.leitor.Peek(len(prefixoVersao))
+	switch {
+	case bytes.HasPrefix(inicio, magicoGzip):
+	case bytes.HasPrefix(inicio, []byte(prefixoVersao)):
+		opcoes, err := lerCabecalhoV2(cis.espiarLinha())
+		if err != nil || opcoes["compressao"] == "" {
+			return nil
+		}
+		if Compressao(opcoes["compressao"]) != CompressaoGzip {
+			return fmt.Errorf("compressao de stream nao suportada: '%s'", opcoes["compressao"])
+		}
+		if _, err := cis.leitor.ReadString('\n'); err != nil {
+			return fmt.Errorf("falha ao ler cabecalho de compressao: %w", err)
+		}
+	default:
+		return nil
+	}
+
+	gz, err := gzip.NewReader(cis.leitor)
+	if err != nil {
+		return fmt.Errorf("falha ao iniciar descompressao: %w", err)
+	}
+	cis.leitor = bufio.NewReader(gz)
+	return nil
+}
+
+// espiarLinha devolve a primeira linha do stream sem consumi-la. Só espera pelos bytes
+// que ainda faltam para achar o fim da linha, para não bloquear numa conexão aberta.
+func (cis *ColaboradorInputStream) espiarLinha() string {
+	n := 1
+	for {
+		dados, err := cis.leitor.Peek(n)
+		if i := bytes.IndexByte(dados, '\n'); i >= 0 {
+			return string(dados[:i])
+		}
+		if err != nil {
+			return string(dados)
+		}
+		n = max(len(dados)+1, cis.leitor.Buffered())
+	}
+}
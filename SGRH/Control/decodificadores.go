@@ -0,0 +1,301 @@
+package Control
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"meu_rh/Models"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// decodificador lê um colaborador por vez de um stream já posicionado no formato certo.
+// proximo devolve io.EOF no fim do stream e *ErroLinha quando só o registro atual está
+// malformado e a leitura pode continuar a partir do seguinte.
+type decodificador interface {
+	proximo() (Models.Colaborador, error)
+}
+
+// leitorLinhas lê linha a linha, sem limite de tamanho, contando as linhas lidas.
+type leitorLinhas struct {
+	r     *bufio.Reader
+	linha int
+}
+
+// ler devolve a próxima linha sem o terminador, ou io.EOF quando não há mais nada.
+func (l *leitorLinhas) ler() (string, error) {
+	texto, err := l.r.ReadString('\n')
+	if err != nil && (err != io.EOF || texto == "") {
+		return "", err
+	}
+	l.linha++
+	return strings.TrimSuffix(strings.TrimSuffix(texto, "\n"), "\r"), nil
+}
+
+type decodificadorLegado struct {
+	linhas     *leitorLinhas
+	iniciado   bool
+	numObjetos int
+	lidos      int
+}
+
+func (d *decodificadorLegado) proximo() (Models.Colaborador, error) {
+	if !d.iniciado {
+		if err := d.lerCabecalho(); err != nil {
+			return nil, err
+		}
+		d.iniciado = true
+	}
+	if d.lidos == d.numObjetos {
+		return nil, io.EOF
+	}
+	d.lidos++
+	i := d.lidos
+
+	line, err := d.linhas.ler()
+	if err == io.EOF {
+		return nil, fmt.Errorf("fim inesperado do stream ao ler colaborador %d de %d", i, d.numObjetos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler dados do colaborador %d: %w", i, err)
+	}
+	numLinha := d.linhas.linha
+
+	// O footer é lido antes de interpretar o registro para que, no modo tolerante,
+	// a leitura continue alinhada no colaborador seguinte.
+	separador, err := d.linhas.ler()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("falha ao ler '---' do footer do colaborador %d: %w", i, err)
+	}
+	if separador != "---" {
+		return nil, fmt.Errorf("esperado '---' no footer do colaborador %d de %d, obteve: '%s'", i, d.numObjetos, separador)
+	}
+	conclusao, err := d.linhas.ler()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("falha ao ler 'Envio concluído.' do footer do colaborador %d: %w", i, err)
+	}
+	if !strings.Contains(conclusao, "Envio concluído.") {
+		return nil, fmt.Errorf("esperado 'Envio concluído.' no footer do colaborador %d de %d, obteve: '%s'", i, d.numObjetos, conclusao)
+	}
+
+	colab, err := colaboradorDeLinhaLegada(line, i)
+	if err != nil {
+		return nil, &ErroLinha{Linha: numLinha, Conteudo: line, Err: err}
+	}
+	return colab, nil
+}
+
+func (d *decodificadorLegado) lerCabecalho() error {
+	headerLine, err := d.linhas.ler()
+	if err == io.EOF {
+		return fmt.Errorf("stream vazio ou formato de cabecalho inesperado")
+	}
+	if err != nil {
+		return fmt.Errorf("falha ao ler cabecalho do stream: %w", err)
+	}
+
+	if _, err := fmt.Sscanf(headerLine, "Enviando dados de %d objetos...", &d.numObjetos); err != nil {
+		return fmt.Errorf("formato de cabecalho invalido '%s': %w", headerLine, err)
+	}
+
+	separador, err := d.linhas.ler()
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("falha ao ler separador '---' pos-cabecalho: %w", err)
+	}
+	// Um stream sem colaboradores pode terminar logo após o cabeçalho.
+	if separador != "---" && (d.numObjetos > 0 || separador != "") {
+		return fmt.Errorf("esperado '---' apos cabecalho, obteve: '%s'", separador)
+	}
+	return nil
+}
+
+// colaboradorDeLinhaLegada interpreta "|ID: <id>, Nome: <nome> Salario: <salario>|",
+// com o nome escapado por escaparNome; obj é a posição do registro no stream, usada
+// nas mensagens de erro.
+func colaboradorDeLinhaLegada(line string, obj int) (Models.Colaborador, error) {
+	if !strings.HasPrefix(line, "|ID:") || !strings.HasSuffix(line, "|") {
+		return nil, fmt.Errorf("formato de linha de colaborador invalido para obj %d: '%s'", obj, line)
+	}
+	content := strings.Trim(line, "|")
+
+	idAndRest := strings.SplitN(content, ", Nome: ", 2)
+	if len(idAndRest) != 2 {
+		return nil, fmt.Errorf("formato de dados de colaborador (esperado 'ID: ..., Nome: ...') invalido na linha '%s' para obj %d", line, obj)
+	}
+	idStrPart := strings.TrimPrefix(idAndRest[0], "ID: ")
+
+	// O salário nunca contém o separador, então a última ocorrência é a verdadeira
+	// mesmo que o nome contenha " Salario: ".
+	sep := strings.LastIndex(idAndRest[1], " Salario: ")
+	if sep < 0 {
+		return nil, fmt.Errorf("formato de dados de colaborador (esperado 'Nome Salario: ...') invalido na linha '%s' para obj %d", line, obj)
+	}
+	nomePart := desescaparNome(idAndRest[1][:sep])
+	salarioStrPart := idAndRest[1][sep+len(" Salario: "):]
+
+	id, err := strconv.Atoi(idStrPart)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao converter ID '%s' para obj %d: %w", idStrPart, obj, err)
+	}
+
+	salario, err := strconv.ParseFloat(salarioStrPart, 64)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao converter Salario '%s' para obj %d: %w", salarioStrPart, obj, err)
+	}
+
+	return Models.StreamedColaborador{
+		ColaboradorBase:  Models.ColaboradorBase{Id: id, Nome: nomePart},
+		SalarioCalculado: salario,
+	}, nil
+}
+
+type decodificadorV2 struct {
+	linhas     *leitorLinhas
+	numObjetos int
+	lidos      int
+}
+
+// novoDecodificadorV2 lê o cabeçalho versionado e escolhe o decodificador indicado
+// por ele: o v2 simples ou o enquadrado.
+func novoDecodificadorV2(linhas *leitorLinhas, formato Formato) (decodificador, error) {
+	linha, err := linhas.ler()
+	if err == io.EOF {
+		return nil, fmt.Errorf("stream vazio")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler cabecalho do stream: %w", err)
+	}
+	opcoes, err := lerCabecalhoV2(linha)
+	if err != nil {
+		return nil, err
+	}
+	numObjetos, err := strconv.Atoi(opcoes["objetos"])
+	if err != nil {
+		return nil, fmt.Errorf("quantidade de objetos invalida no cabecalho: '%s'", opcoes["objetos"])
+	}
+	if opcoes["enquadrado"] == "1" {
+		return newDecodificadorEnquadrado(linhas, numObjetos, 1), nil
+	}
+	if formato == FormatoEnquadrado {
+		return nil, fmt.Errorf("esperado stream enquadrado, obteve cabecalho: '%s'", linha)
+	}
+	return &decodificadorV2{linhas: linhas, numObjetos: numObjetos}, nil
+}
+
+func (d *decodificadorV2) proximo() (Models.Colaborador, error) {
+	if d.lidos == d.numObjetos {
+		return nil, d.lerTrailer()
+	}
+	d.lidos++
+	i := d.lidos
+
+	linha, err := d.linhas.ler()
+	if err == io.EOF {
+		return nil, fmt.Errorf("fim inesperado do stream ao ler colaborador %d de %d", i, d.numObjetos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler dados do colaborador %d: %w", i, err)
+	}
+	colab, err := colaboradorDeLinhaV2(linha)
+	if err != nil {
+		return nil, &ErroLinha{Linha: d.linhas.linha, Conteudo: linha, Err: fmt.Errorf("registro %d invalido: %w", i, err)}
+	}
+	return colab, nil
+}
+
+// lerTrailer confere a finalização do stream e devolve io.EOF se ela estiver correta.
+func (d *decodificadorV2) lerTrailer() error {
+	linha, err := d.linhas.ler()
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("falha ao ler finalizacao do stream: %w", err)
+	}
+	if linha != trailerV2 {
+		return fmt.Errorf("esperado '%s' apos %d colaboradores, obteve: '%s'", trailerV2, d.numObjetos, linha)
+	}
+	return io.EOF
+}
+
+type decodificadorNDJSON struct {
+	linhas *leitorLinhas
+}
+
+func (d *decodificadorNDJSON) proximo() (Models.Colaborador, error) {
+	for {
+		linha, err := d.linhas.ler()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler linha %d: %w", d.linhas.linha+1, err)
+		}
+		if strings.TrimSpace(linha) == "" {
+			continue
+		}
+		var reg registroColaborador
+		if err := json.Unmarshal([]byte(linha), &reg); err != nil {
+			return nil, &ErroLinha{Linha: d.linhas.linha, Conteudo: linha, Err: fmt.Errorf("JSON invalido: %w", err)}
+		}
+		colab, err := reg.paraColaborador()
+		if err != nil {
+			return nil, &ErroLinha{Linha: d.linhas.linha, Conteudo: linha, Err: fmt.Errorf("registro invalido: %w", err)}
+		}
+		return colab, nil
+	}
+}
+
+type decodificadorCSV struct {
+	r        *csv.Reader
+	iniciado bool
+	lidos    int
+}
+
+func newDecodificadorCSV(origem io.Reader) *decodificadorCSV {
+	r := csv.NewReader(origem)
+	r.FieldsPerRecord = len(cabecalhoCSV)
+	return &decodificadorCSV{r: r}
+}
+
+func (d *decodificadorCSV) proximo() (Models.Colaborador, error) {
+	if !d.iniciado {
+		cabecalho, err := d.r.Read()
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler cabecalho CSV: %w", err)
+		}
+		if !slices.Equal(cabecalho, cabecalhoCSV) {
+			return nil, fmt.Errorf("cabecalho CSV inesperado: %v", cabecalho)
+		}
+		d.iniciado = true
+	}
+
+	campos, err := d.r.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	d.lidos++
+	if err != nil {
+		// Erros de sintaxe afetam só o registro atual; o csv.Reader segue na linha seguinte.
+		var erroCSV *csv.ParseError
+		if errors.As(err, &erroCSV) {
+			return nil, &ErroLinha{
+				Linha:    erroCSV.StartLine,
+				Conteudo: strings.Join(campos, ","),
+				Err:      fmt.Errorf("falha ao ler registro CSV %d: %w", d.lidos, erroCSV.Err),
+			}
+		}
+		return nil, fmt.Errorf("falha ao ler registro CSV %d: %w", d.lidos, err)
+	}
+
+	reg, err := registroDeCSV(campos)
+	if err == nil {
+		var colab Models.Colaborador
+		if colab, err = reg.paraColaborador(); err == nil {
+			return colab, nil
+		}
+	}
+	linha, _ := d.r.FieldPos(0)
+	return nil, &ErroLinha{Linha: linha, Conteudo: strings.Join(campos, ","), Err: fmt.Errorf("registro CSV %d invalido: %w", d.lidos, err)}
+}
@@ -0,0 +1,357 @@
+package Control
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"meu_rh/Models"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Transferência enquadrada de um lote pela mesma conexão, nos dois sentidos:
+//
+//	emissor  -> SGRH-STREAM/2 objetos=<N> enquadrado=1 lote=<id>
+//	receptor -> RETOMAR <k>                   (registros do lote que ele já tem)
+//	emissor  -> <seq> <crc32> |registro v2|   para seq = k+1 .. N
+//	receptor -> ACK <seq>                     (depois de guardar cada registro)
+//	emissor  -> FIM sha256=<hex>              (das linhas de registro enviadas nesta conexão)
+//	receptor -> CONCLUIDO <N>  ou  ERRO <motivo>
+//
+// Se a conexão cair, o emissor reconecta e envia o mesmo lote de novo; o RETOMAR diz a
+// partir de onde continuar. Sem o diálogo (EscreverTodosOsDados com FormatoEnquadrado,
+// por exemplo num arquivo), o cabeçalho não leva lote e a sequência começa em 1.
+const (
+	msgRetomar   = "RETOMAR"
+	msgAck       = "ACK"
+	msgConcluido = "CONCLUIDO"
+	msgErro      = "ERRO"
+)
+
+var (
+	ErrLoteJaRecebido   = errors.New("lote ja recebido por completo")
+	errResumoDivergente = errors.New("resumo SHA-256 do lote nao confere")
+)
+
+func cabecalhoEnquadrado(numObjetos int, lote string) string {
+	cabecalho := fmt.Sprintf("%s%d objetos=%d enquadrado=1", prefixoVersao, versaoAtual, numObjetos)
+	if lote != "" {
+		cabecalho += " lote=" + lote
+	}
+	return cabecalho + "\n"
+}
+
+// linhaEnquadrada prefixa o registro v2 com a sequência e o CRC32 (IEEE) do registro.
+func linhaEnquadrada(seq int, registro string) string {
+	return fmt.Sprintf("%d %08x %s\n", seq, crc32.ChecksumIEEE([]byte(registro)), registro)
+}
+
+// escreverEnquadrado escreve os registros a partir da sequência inicio e o trailer.
+func (cos *ColaboradorOuputStream) escreverEnquadrado(w io.Writer, inicio int) error {
+	resumo := sha256.New()
+	for seq := inicio; seq <= len(cos.Colaboradores); seq++ {
+		colab := cos.Colaboradores[seq-1]
+		linha := linhaEnquadrada(seq, strings.TrimSuffix(novoRegistro(colab).paraLinhaV2(), "\n"))
+		resumo.Write([]byte(linha))
+		if _, err := io.WriteString(w, linha); err != nil {
+			return fmt.Errorf("falha ao escrever os dados do colaborador ID %d: %w", colab.GetId(), err)
+		}
+	}
+	if _, err := fmt.Fprintf(w, "%s sha256=%x\n", trailerV2, resumo.Sum(nil)); err != nil {
+		return fmt.Errorf("falha ao escrever finalizacao: %w", err)
+	}
+	return nil
+}
+
+// EnviarEnquadrado envia os colaboradores como o lote informado e só devolve nil quando
+// o receptor confirma o lote inteiro. Em caso de erro, Confirmados indica até onde o
+// receptor garantiu ter recebido; basta reconectar e chamar de novo com o mesmo lote
+// para continuar dali. As confirmações são lidas até a conexão ser fechada.
+func (cos *ColaboradorOuputStream) EnviarEnquadrado(conn io.ReadWriter, lote string) error {
+	if lote == "" || strings.ContainsAny(lote, " \t\r\n") {
+		return fmt.Errorf("identificador de lote invalido: '%s'", lote)
+	}
+	total := len(cos.Colaboradores)
+	if _, err := io.WriteString(conn, cabecalhoEnquadrado(total, lote)); err != nil {
+		return fmt.Errorf("falha ao escrever cabecalho: %w", err)
+	}
+
+	respostas := &leitorLinhas{r: bufio.NewReader(conn)}
+	linha, err := respostas.ler()
+	if err != nil {
+		return fmt.Errorf("falha ao ler resposta do receptor: %w", err)
+	}
+	if motivo, ok := strings.CutPrefix(linha, msgErro+" "); ok {
+		return fmt.Errorf("receptor recusou o lote: %s", motivo)
+	}
+	var inicio int
+	if _, err := fmt.Sscanf(linha, msgRetomar+" %d", &inicio); err != nil || inicio < 0 || inicio > total {
+		return fmt.Errorf("resposta inesperada do receptor: '%s'", linha)
+	}
+	confirmados := new(atomic.Int64)
+	confirmados.Store(int64(inicio))
+	cos.confirmados.Store(confirmados)
+
+	// As confirmações são lidas em paralelo para que nenhum dos lados fique bloqueado
+	// com o buffer da conexão cheio. Se a escrita falhar, a leitura pode continuar depois
+	// do retorno, mas só atualiza o contador desta chamada, não o de uma nova tentativa.
+	resultado := make(chan error, 1)
+	go func() {
+		resultado <- lerConfirmacoes(respostas, total, confirmados)
+	}()
+
+	if err := cos.escreverEnquadrado(conn, inicio+1); err != nil {
+		return err
+	}
+	return <-resultado
+}
+
+func lerConfirmacoes(respostas *leitorLinhas, total int, confirmados *atomic.Int64) error {
+	for {
+		linha, err := respostas.ler()
+		if err != nil {
+			return fmt.Errorf("conexao encerrada antes da confirmacao do lote (%d de %d confirmados): %w", confirmados.Load(), total, err)
+		}
+		comando, argumento, _ := strings.Cut(linha, " ")
+		switch comando {
+		case msgAck:
+			seq, err := strconv.Atoi(argumento)
+			if err != nil {
+				return fmt.Errorf("confirmacao invalida do receptor: '%s'", linha)
+			}
+			confirmados.Store(int64(seq))
+		case msgConcluido:
+			confirmados.Store(int64(total))
+			return nil
+		case msgErro:
+			return fmt.Errorf("receptor recusou o lote: %s", argumento)
+		default:
+			return fmt.Errorf("resposta inesperada do receptor: '%s'", linha)
+		}
+	}
+}
+
+// Confirmados devolve quantos colaboradores o receptor já confirmou no envio enquadrado.
+func (cos *ColaboradorOuputStream) Confirmados() int {
+	if confirmados := cos.confirmados.Load(); confirmados != nil {
+		return int(confirmados.Load())
+	}
+	return 0
+}
+
+type decodificadorEnquadrado struct {
+	linhas     *leitorLinhas
+	numObjetos int
+	proxima    int
+	resumo     hash.Hash
+}
+
+func newDecodificadorEnquadrado(linhas *leitorLinhas, numObjetos, inicio int) *decodificadorEnquadrado {
+	return &decodificadorEnquadrado{linhas: linhas, numObjetos: numObjetos, proxima: inicio, resumo: sha256.New()}
+}
+
+// proximo confere sequência e CRC antes de interpretar o registro. Quadros fora de ordem
+// ou corrompidos encerram a leitura: a integridade do lote é o objetivo do formato.
+func (d *decodificadorEnquadrado) proximo() (Models.Colaborador, error) {
+	if d.proxima > d.numObjetos {
+		return nil, d.lerTrailer()
+	}
+	linha, err := d.linhas.ler()
+	if err == io.EOF {
+		return nil, fmt.Errorf("fim inesperado do stream ao ler colaborador %d de %d", d.proxima, d.numObjetos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler dados do colaborador %d: %w", d.proxima, err)
+	}
+
+	seqTexto, resto, ok := strings.Cut(linha, " ")
+	crcTexto, registro, okCRC := strings.Cut(resto, " ")
+	seq, err := strconv.Atoi(seqTexto)
+	if !ok || !okCRC || err != nil {
+		return nil, fmt.Errorf("linha %d: quadro malformado: '%s'", d.linhas.linha, linha)
+	}
+	if seq != d.proxima {
+		return nil, fmt.Errorf("linha %d: esperada sequencia %d, obteve %d", d.linhas.linha, d.proxima, seq)
+	}
+	if crcTexto != fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(registro))) {
+		return nil, fmt.Errorf("linha %d: CRC32 do registro %d nao confere", d.linhas.linha, seq)
+	}
+	d.resumo.Write([]byte(linha + "\n"))
+	d.proxima++
+
+	colab, err := colaboradorDeLinhaV2(registro)
+	if err != nil {
+		return nil, &ErroLinha{Linha: d.linhas.linha, Conteudo: linha, Err: fmt.Errorf("registro %d invalido: %w", seq, err)}
+	}
+	return colab, nil
+}
+
+func (d *decodificadorEnquadrado) lerTrailer() error {
+	linha, err := d.linhas.ler()
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("falha ao ler finalizacao do stream: %w", err)
+	}
+	prefixo := trailerV2 + " sha256="
+	if !strings.HasPrefix(linha, prefixo) {
+		return fmt.Errorf("esperado '%s...' apos %d colaboradores, obteve: '%s'", prefixo, d.numObjetos, linha)
+	}
+	if linha != fmt.Sprintf("%s%x", prefixo, d.resumo.Sum(nil)) {
+		return errResumoDivergente
+	}
+	return io.EOF
+}
+
+// retencaoPadrao é por quanto tempo um lote concluído continua conhecido pelo receptor.
+const retencaoPadrao = 10 * time.Minute
+
+// ReceptorEnquadrado atende envios de EnviarEnquadrado. Os registros de lotes
+// incompletos ficam guardados para que o emissor possa retomá-los em outra conexão.
+type ReceptorEnquadrado struct {
+	// Retencao é por quanto tempo um lote concluído é lembrado, para reconhecer um
+	// reenvio cujo CONCLUIDO se perdeu. Passado esse tempo, ele é descartado na próxima
+	// conexão, e um reenvio com o mesmo nome passa a ser um lote novo.
+	Retencao time.Duration
+	mu       sync.Mutex
+	lotes    map[string]*loteEnquadrado
+}
+
+type loteEnquadrado struct {
+	total         int
+	colaboradores []Models.Colaborador
+	emUso         bool
+	concluido     bool
+	concluidoEm   time.Time
+}
+
+func NewReceptorEnquadrado() *ReceptorEnquadrado {
+	return &ReceptorEnquadrado{Retencao: retencaoPadrao, lotes: make(map[string]*loteEnquadrado)}
+}
+
+// Receber atende uma conexão e devolve o nome do lote e, quando ele se completa, todos os
+// seus colaboradores. Se a conexão cair no meio, devolve o erro e guarda o que já foi
+// confirmado. Um lote já entregue que seja reenviado (porque o CONCLUIDO se perdeu) é
+// confirmado de novo ao emissor e devolve ErrLoteJaRecebido.
+func (r *ReceptorEnquadrado) Receber(conn io.ReadWriter) (string, []Models.Colaborador, error) {
+	linhas := &leitorLinhas{r: bufio.NewReader(conn)}
+	cabecalho, err := linhas.ler()
+	if err != nil {
+		return "", nil, fmt.Errorf("falha ao ler cabecalho do lote: %w", err)
+	}
+	opcoes, err := lerCabecalhoV2(cabecalho)
+	if err == nil && (opcoes["enquadrado"] != "1" || opcoes["lote"] == "") {
+		err = fmt.Errorf("cabecalho sem enquadramento ou lote: '%s'", cabecalho)
+	}
+	var total int
+	if err == nil {
+		if total, err = strconv.Atoi(opcoes["objetos"]); err != nil {
+			err = fmt.Errorf("quantidade de objetos invalida no cabecalho: '%s'", opcoes["objetos"])
+		}
+	}
+	nome := opcoes["lote"]
+	var lote *loteEnquadrado
+	if err == nil {
+		lote, err = r.reservar(nome, total)
+	}
+	if err != nil {
+		fmt.Fprintf(conn, "%s %v\n", msgErro, err)
+		return nome, nil, err
+	}
+	defer r.liberar(nome)
+
+	r.mu.Lock()
+	recebidos := len(lote.colaboradores)
+	if lote.concluido {
+		recebidos = total
+	}
+	r.mu.Unlock()
+	if _, err := fmt.Fprintf(conn, "%s %d\n", msgRetomar, recebidos); err != nil {
+		return nome, nil, fmt.Errorf("falha ao responder ao emissor: %w", err)
+	}
+
+	dec := newDecodificadorEnquadrado(linhas, total, recebidos+1)
+	for {
+		colab, err := dec.proximo()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if errors.Is(err, errResumoDivergente) {
+				// Não há como saber qual registro divergiu: o lote recomeça do zero.
+				r.mu.Lock()
+				lote.colaboradores = nil
+				r.mu.Unlock()
+			}
+			fmt.Fprintf(conn, "%s %v\n", msgErro, err)
+			return nome, nil, err
+		}
+		// Recebidos lê lote.colaboradores de outras goroutines, sob r.mu.
+		r.mu.Lock()
+		lote.colaboradores = append(lote.colaboradores, colab)
+		recebidos = len(lote.colaboradores)
+		r.mu.Unlock()
+		if _, err := fmt.Fprintf(conn, "%s %d\n", msgAck, recebidos); err != nil {
+			return nome, nil, fmt.Errorf("falha ao confirmar registro %d: %w", recebidos, err)
+		}
+	}
+
+	r.mu.Lock()
+	colaboradores, jaEntregue := lote.colaboradores, lote.concluido
+	lote.colaboradores = nil
+	if !jaEntregue {
+		lote.concluido, lote.concluidoEm = true, time.Now()
+	}
+	r.mu.Unlock()
+	// Se o CONCLUIDO se perder, o emissor reenvia o lote e é confirmado de novo.
+	fmt.Fprintf(conn, "%s %d\n", msgConcluido, total)
+	if jaEntregue {
+		return nome, nil, ErrLoteJaRecebido
+	}
+	return nome, colaboradores, nil
+}
+
+// Recebidos devolve quantos registros do lote já foram confirmados e ainda aguardam o fim
+// do envio; zero para lotes desconhecidos ou já entregues.
+func (r *ReceptorEnquadrado) Recebidos(nome string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lote, ok := r.lotes[nome]; ok {
+		return len(lote.colaboradores)
+	}
+	return 0
+}
+
+func (r *ReceptorEnquadrado) reservar(nome string, total int) (*loteEnquadrado, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	limite := time.Now().Add(-r.Retencao)
+	for outro, lote := range r.lotes {
+		if lote.concluido && !lote.emUso && lote.concluidoEm.Before(limite) {
+			delete(r.lotes, outro)
+		}
+	}
+	lote, ok := r.lotes[nome]
+	if ok && lote.emUso {
+		return nil, fmt.Errorf("lote '%s' ja esta sendo recebido em outra conexao", nome)
+	}
+	if !ok || lote.total != total {
+		lote = &loteEnquadrado{total: total}
+		r.lotes[nome] = lote
+	}
+	lote.emUso = true
+	return lote, nil
+}
+
+func (r *ReceptorEnquadrado) liberar(nome string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lote, ok := r.lotes[nome]; ok {
+		lote.emUso = false
+	}
+}
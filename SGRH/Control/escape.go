@@ -0,0 +1,81 @@
+package Control
+
+import "strings"
+
+// Nos formatos de linha (legado e v2) os nomes são escritos com escapes de barra
+// invertida, para que nenhum caractere do nome quebre o enquadramento do stream:
+//
+//	\\  barra invertida     \n  quebra de linha     \r  retorno de carro
+//	\;  ponto e vírgula (só no v2, onde separa os campos)
+//
+// Na leitura, uma barra seguida de qualquer outro caractere é mantida como está, o que
+// preserva nomes de streams antigos que continham barras sem escape.
+
+// escaparNome aplica os escapes; extras lista caracteres ASCII adicionais a escapar com
+// '\'. O nome é percorrido byte a byte: todos os caracteres escapados são ASCII, que
+// nunca aparecem dentro de uma sequência UTF-8, e bytes inválidos passam intactos em vez
+// de virarem U+FFFD.
+func escaparNome(nome, extras string) string {
+	if !strings.ContainsAny(nome, "\\\n\r"+extras) {
+		return nome
+	}
+	var sb strings.Builder
+	for i := 0; i < len(nome); i++ {
+		switch b := nome[i]; {
+		case b == '\n':
+			sb.WriteString(`\n`)
+		case b == '\r':
+			sb.WriteString(`\r`)
+		case b == '\\' || strings.IndexByte(extras, b) >= 0:
+			sb.WriteByte('\\')
+			sb.WriteByte(b)
+		default:
+			sb.WriteByte(b)
+		}
+	}
+	return sb.String()
+}
+
+func desescaparNome(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case '\\', ';':
+			sb.WriteByte(s[i+1])
+		default:
+			sb.WriteByte('\\')
+			continue
+		}
+		i++
+	}
+	return sb.String()
+}
+
+// dividirEscapado separa s em sep, ignorando ocorrências precedidas de barra invertida.
+func dividirEscapado(s, sep string) []string {
+	var partes []string
+	inicio := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], sep) {
+			partes = append(partes, s[inicio:i])
+			i += len(sep) - 1
+			inicio = i + 1
+		}
+	}
+	return append(partes, s[inicio:])
+}
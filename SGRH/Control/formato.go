@@ -0,0 +1,36 @@
+package Control
+
+import "fmt"
+
+// Formato seleciona a codificação usada pelos streams de colaboradores. O valor zero
+// escreve no FormatoLegado e, na leitura, detecta o formato pelo início do stream.
+type Formato string
+
+const (
+	// FormatoLegado é o formato original "|ID: x, Nome: y Salario: z|". Não preserva o
+	// tipo do colaborador: a leitura devolve sempre Models.StreamedColaborador.
+	FormatoLegado Formato = "legado"
+	// FormatoCSV escreve uma linha de cabeçalho seguida de um colaborador por registro.
+	// Nomes com vírgulas, aspas ou quebras de linha vão entre aspas (RFC 4180); como em
+	// qualquer leitor de CSV, um "\r\n" dentro do nome é lido de volta como "\n".
+	FormatoCSV Formato = "csv"
+	// FormatoNDJSON escreve um objeto JSON por linha.
+	FormatoNDJSON Formato = "ndjson"
+	// FormatoV2 é o formato versionado: cabeçalho "SGRH-STREAM/2", uma linha por
+	// colaborador com o tipo e todos os seus campos, e um trailer "FIM".
+	FormatoV2 Formato = "v2"
+	// FormatoEnquadrado é o v2 com integridade verificável: cada linha leva número de
+	// sequência e CRC32, e o trailer traz o SHA-256 das linhas enviadas. É o formato usado
+	// por EnviarEnquadrado e ReceptorEnquadrado, que permitem retomar um lote interrompido.
+	FormatoEnquadrado Formato = "enquadrado"
+)
+
+func formatoOuPadrao(f Formato) (Formato, error) {
+	switch f {
+	case "":
+		return FormatoLegado, nil
+	case FormatoLegado, FormatoCSV, FormatoNDJSON, FormatoV2, FormatoEnquadrado:
+		return f, nil
+	}
+	return "", fmt.Errorf("formato de stream desconhecido: '%s'", f)
+}
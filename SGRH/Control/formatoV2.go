@@ -0,0 +1,116 @@
+package Control
+
+import (
+	"fmt"
+	"meu_rh/Models"
+	"strconv"
+	"strings"
+)
+
+const (
+	// prefixoVersao identifica streams versionados; é seguido do número da versão.
+	prefixoVersao = "SGRH-STREAM/"
+	versaoAtual   = 2
+	trailerV2     = "FIM"
+)
+
+// cabecalhoV2 monta a primeira linha do stream, com as opções em pares chave=valor.
+func cabecalhoV2(numObjetos int) string {
+	return fmt.Sprintf("%s%d objetos=%d\n", prefixoVersao, versaoAtual, numObjetos)
+}
+
+// lerCabecalhoV2 valida a versão e devolve as opções do cabeçalho.
+func lerCabecalhoV2(linha string) (map[string]string, error) {
+	campos := strings.Fields(linha)
+	if len(campos) == 0 || !strings.HasPrefix(campos[0], prefixoVersao) {
+		return nil, fmt.Errorf("cabecalho versionado invalido: '%s'", linha)
+	}
+	versao, err := strconv.Atoi(strings.TrimPrefix(campos[0], prefixoVersao))
+	if err != nil {
+		return nil, fmt.Errorf("versao invalida no cabecalho '%s': %w", linha, err)
+	}
+	if versao > versaoAtual {
+		return nil, fmt.Errorf("versao %d do stream nao suportada (maxima: %d)", versao, versaoAtual)
+	}
+
+	opcoes := make(map[string]string)
+	for _, campo := range campos[1:] {
+		chave, valor, ok := strings.Cut(campo, "=")
+		if !ok {
+			return nil, fmt.Errorf("opcao invalida no cabecalho: '%s'", campo)
+		}
+		opcoes[chave] = valor
+	}
+	return opcoes, nil
+}
+
+// paraLinhaV2 escreve o registro como "|tipo=...; id=...; nome=...; <campos do tipo>|".
+// O nome é o único valor livre e vai com os escapes de escaparNome, incluindo '\;'.
+func (r registroColaborador) paraLinhaV2() string {
+	campos := []string{
+		"tipo=" + r.Tipo,
+		"id=" + strconv.Itoa(r.Id),
+		"nome=" + escaparNome(r.Nome, ";"),
+	}
+	if r.SalarioMensal != nil {
+		campos = append(campos, "salario_mensal="+formatarOpcional(r.SalarioMensal))
+	}
+	if r.HorasTrabalhadas != nil {
+		campos = append(campos, "horas_trabalhadas="+formatarOpcionalInt(r.HorasTrabalhadas))
+	}
+	if r.ValorHora != nil {
+		campos = append(campos, "valor_hora="+formatarOpcional(r.ValorHora))
+	}
+	if r.AuxilioEstagio != nil {
+		campos = append(campos, "auxilio_estagio="+formatarOpcional(r.AuxilioEstagio))
+	}
+	campos = append(campos, "salario_calculado="+strconv.FormatFloat(r.SalarioCalculado, 'f', -1, 64))
+	return "|" + strings.Join(campos, "; ") + "|\n"
+}
+
+func colaboradorDeLinhaV2(linha string) (Models.Colaborador, error) {
+	reg, err := registroDeLinhaV2(linha)
+	if err != nil {
+		return nil, err
+	}
+	return reg.paraColaborador()
+}
+
+func registroDeLinhaV2(linha string) (registroColaborador, error) {
+	var r registroColaborador
+	if len(linha) < 2 || !strings.HasPrefix(linha, "|") || !strings.HasSuffix(linha, "|") {
+		return r, fmt.Errorf("linha sem delimitadores '|': '%s'", linha)
+	}
+
+	var err error
+	for _, campo := range dividirEscapado(linha[1:len(linha)-1], "; ") {
+		chave, valor, ok := strings.Cut(campo, "=")
+		if !ok {
+			return r, fmt.Errorf("campo sem '=': '%s'", campo)
+		}
+		switch chave {
+		case "tipo":
+			r.Tipo = valor
+		case "id":
+			r.Id, err = strconv.Atoi(valor)
+		case "nome":
+			r.Nome = desescaparNome(valor)
+		case "salario_mensal":
+			r.SalarioMensal, err = lerOpcional(valor)
+		case "horas_trabalhadas":
+			r.HorasTrabalhadas, err = lerOpcionalInt(valor)
+		case "valor_hora":
+			r.ValorHora, err = lerOpcional(valor)
+		case "auxilio_estagio":
+			r.AuxilioEstagio, err = lerOpcional(valor)
+		case "salario_calculado":
+			r.SalarioCalculado, err = strconv.ParseFloat(valor, 64)
+		default:
+			// Campos desconhecidos são ignorados para que versões futuras possam acrescentá-los.
+		}
+		if err != nil {
+			return r, fmt.Errorf("valor invalido para '%s': %w", chave, err)
+		}
+	}
+	return r, nil
+}
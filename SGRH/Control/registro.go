@@ -0,0 +1,152 @@
+package Control
+
+import (
+	"fmt"
+	"meu_rh/Models"
+	"strconv"
+)
+
+// registroColaborador é a forma serializada de um colaborador nos formatos CSV e NDJSON.
+// Só os campos do tipo informado são preenchidos.
+type registroColaborador struct {
+	Tipo             string   `json:"tipo"`
+	Id               int      `json:"id"`
+	Nome             string   `json:"nome"`
+	SalarioMensal    *float64 `json:"salario_mensal,omitempty"`
+	HorasTrabalhadas *int     `json:"horas_trabalhadas,omitempty"`
+	ValorHora        *float64 `json:"valor_hora,omitempty"`
+	AuxilioEstagio   *float64 `json:"auxilio_estagio,omitempty"`
+	SalarioCalculado float64  `json:"salario_calculado"`
+}
+
+var cabecalhoCSV = []string{"tipo", "id", "nome", "salario_mensal", "horas_trabalhadas", "valor_hora", "auxilio_estagio", "salario_calculado"}
+
+func novoRegistro(c Models.Colaborador) registroColaborador {
+	r := registroColaborador{
+		Tipo:             Models.TipoDe(c),
+		Id:               c.GetId(),
+		Nome:             c.GetNome(),
+		SalarioCalculado: c.CalcularSalario(),
+	}
+	switch v := c.(type) {
+	case Models.Efetivo:
+		r.SalarioMensal = &v.SalarioMensal
+	case *Models.Efetivo:
+		r.SalarioMensal = &v.SalarioMensal
+	case Models.Autonomo:
+		r.HorasTrabalhadas, r.ValorHora = &v.HorasTrabalhadas, &v.ValorHora
+	case *Models.Autonomo:
+		r.HorasTrabalhadas, r.ValorHora = &v.HorasTrabalhadas, &v.ValorHora
+	case Models.Estagiario:
+		r.AuxilioEstagio = &v.AuxilioEstagio
+	case *Models.Estagiario:
+		r.AuxilioEstagio = &v.AuxilioEstagio
+	}
+	if r.Tipo == "" {
+		// Tipos desconhecidos viajam apenas com o salário já calculado.
+		r.Tipo = Models.TipoStreamed
+	}
+	return r
+}
+
+// paraColaborador reconstrói o valor concreto descrito pelo registro.
+func (r registroColaborador) paraColaborador() (Models.Colaborador, error) {
+	base := Models.ColaboradorBase{Id: r.Id, Nome: r.Nome}
+	switch r.Tipo {
+	case Models.TipoEfetivo:
+		if r.SalarioMensal == nil {
+			return nil, fmt.Errorf("colaborador %d do tipo efetivo sem salario_mensal", r.Id)
+		}
+		return Models.Efetivo{ColaboradorBase: base, SalarioMensal: *r.SalarioMensal}, nil
+	case Models.TipoAutonomo:
+		if r.HorasTrabalhadas == nil || r.ValorHora == nil {
+			return nil, fmt.Errorf("colaborador %d do tipo autonomo sem horas_trabalhadas ou valor_hora", r.Id)
+		}
+		return Models.Autonomo{ColaboradorBase: base, HorasTrabalhadas: *r.HorasTrabalhadas, ValorHora: *r.ValorHora}, nil
+	case Models.TipoEstagiario:
+		if r.AuxilioEstagio == nil {
+			return nil, fmt.Errorf("colaborador %d do tipo estagiario sem auxilio_estagio", r.Id)
+		}
+		return Models.Estagiario{ColaboradorBase: base, AuxilioEstagio: *r.AuxilioEstagio}, nil
+	case Models.TipoStreamed:
+		return Models.StreamedColaborador{ColaboradorBase: base, SalarioCalculado: r.SalarioCalculado}, nil
+	}
+	return nil, fmt.Errorf("tipo de colaborador desconhecido: '%s'", r.Tipo)
+}
+
+func (r registroColaborador) paraCSV() []string {
+	return []string{
+		r.Tipo,
+		strconv.Itoa(r.Id),
+		r.Nome,
+		formatarOpcional(r.SalarioMensal),
+		formatarOpcionalInt(r.HorasTrabalhadas),
+		formatarOpcional(r.ValorHora),
+		formatarOpcional(r.AuxilioEstagio),
+		strconv.FormatFloat(r.SalarioCalculado, 'f', -1, 64),
+	}
+}
+
+func registroDeCSV(campos []string) (registroColaborador, error) {
+	var r registroColaborador
+	if len(campos) != len(cabecalhoCSV) {
+		return r, fmt.Errorf("esperados %d campos, obtidos %d", len(cabecalhoCSV), len(campos))
+	}
+	var err error
+	r.Tipo, r.Nome = campos[0], campos[2]
+	if r.Id, err = strconv.Atoi(campos[1]); err != nil {
+		return r, fmt.Errorf("id invalido '%s': %w", campos[1], err)
+	}
+	if r.SalarioMensal, err = lerOpcional(campos[3]); err != nil {
+		return r, fmt.Errorf("salario_mensal invalido: %w", err)
+	}
+	if r.HorasTrabalhadas, err = lerOpcionalInt(campos[4]); err != nil {
+		return r, fmt.Errorf("horas_trabalhadas invalido: %w", err)
+	}
+	if r.ValorHora, err = lerOpcional(campos[5]); err != nil {
+		return r, fmt.Errorf("valor_hora invalido: %w", err)
+	}
+	if r.AuxilioEstagio, err = lerOpcional(campos[6]); err != nil {
+		return r, fmt.Errorf("auxilio_estagio invalido: %w", err)
+	}
+	if r.SalarioCalculado, err = strconv.ParseFloat(campos[7], 64); err != nil {
+		return r, fmt.Errorf("salario_calculado invalido: %w", err)
+	}
+	return r, nil
+}
+
+func formatarOpcional(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatarOpcionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func lerOpcional(s string) (*float64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func lerOpcionalInt(s string) (*int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"meu_rh/Control"
@@ -190,4 +192,231 @@ func main() {
 	}
 	fmt.Println(">>>> FIM DO TESTE 6 <<<<")
 	time.Sleep(1 * time.Second)
-}
\ No newline at end of file
+
+	fmt.Println("\n>>>> INICIANDO TESTE 7: FORMATOS CSV E NDJSON (ida e volta preservando o tipo) <<<<")
+	for _, formato := range []Control.Formato{Control.FormatoCSV, Control.FormatoNDJSON} {
+		var buf bytes.Buffer
+		streamFormatado := Control.NewColaboradorOutputStream(&buf, allColabs)
+		streamFormatado.Formato = formato
+		if err := streamFormatado.EscreverTodosOsDados(); err != nil {
+			fmt.Printf("Erro no teste 7 ao escrever %s: %v\n", formato, err)
+			continue
+		}
+		fmt.Printf("Saída em %s:\n%s", formato, buf.String())
+
+		leituraFormatada := Control.NewColaboradorInputStream(&buf)
+		leituraFormatada.Formato = formato
+		dadosLidos, err := leituraFormatada.LerTodosOsDados()
+		if err != nil {
+			fmt.Printf("Erro no teste 7 ao ler %s: %v\n", formato, err)
+			continue
+		}
+		for _, colab := range dadosLidos {
+			fmt.Printf("  %T -> %+v\n", colab, colab)
+		}
+	}
+	fmt.Println(">>>> FIM DO TESTE 7 <<<<")
+
+	fmt.Println("\n>>>> INICIANDO TESTE 8: FORMATO VERSIONADO V2 (detectado automaticamente na leitura) <<<<")
+	var bufV2 bytes.Buffer
+	streamV2 := Control.NewColaboradorOutputStream(&bufV2, allColabs)
+	streamV2.Formato = Control.FormatoV2
+	if err := streamV2.EscreverTodosOsDados(); err != nil {
+		fmt.Printf("Erro no teste 8 ao escrever: %v\n", err)
+	} else {
+		fmt.Print(bufV2.String())
+		dadosLidosV2, err := Control.NewColaboradorInputStream(&bufV2).LerTodosOsDados()
+		if err != nil {
+			fmt.Printf("Erro no teste 8 ao ler: %v\n", err)
+		}
+		for _, colab := range dadosLidosV2 {
+			fmt.Printf("  %T -> %+v\n", colab, colab)
+		}
+	}
+	fmt.Println(">>>> FIM DO TESTE 8 <<<<")
+
+	fmt.Println("\n>>>> INICIANDO TESTE 9: LEITURA INCREMENTAL E MODO TOLERANTE <<<<")
+	// Os registros são entregues à medida que chegam pelo pipe, sem esperar o fim do stream.
+	leituraPipe, escritaPipe := io.Pipe()
+	go func() {
+		defer escritaPipe.Close()
+		linhas := []string{
+			`{"tipo":"efetivo","id":301,"nome":"Diego Lima","salario_mensal":4200}`,
+			`{"tipo":"efetivo","id":"trezentos e dois"}`,
+			`{"tipo":"estagiario","id":303,"nome":"Elisa Prado","auxilio_estagio":1100}`,
+			`{"tipo":"gerente","id":304,"nome":"Fabio Reis"}`,
+			`{"tipo":"autonomo","id":305,"nome":"Gabi Souza","horas_trabalhadas":20,"valor_hora":90}`,
+		}
+		for _, linha := range linhas {
+			fmt.Fprintln(escritaPipe, linha)
+			time.Sleep(200 * time.Millisecond)
+		}
+	}()
+	streamIncremental := Control.NewColaboradorInputStream(leituraPipe)
+	streamIncremental.Tolerante = true
+	for streamIncremental.Next() {
+		colab := streamIncremental.Colaborador()
+		fmt.Printf("  [%s] recebido: ID %d, %s\n", time.Now().Format("15:04:05.000"), colab.GetId(), colab.GetNome())
+	}
+	if err := streamIncremental.Err(); err != nil {
+		fmt.Printf("Erro no teste 9: %v\n", err)
+	}
+	for _, ignorada := range streamIncremental.LinhasIgnoradas() {
+		fmt.Printf("  Linha ignorada %d (%v): %s\n", ignorada.Linha, ignorada.Err, ignorada.Conteudo)
+	}
+
+	// A mesma leitura com range; no formato legado, a linha malformada é relatada e pulada.
+	legadoComErro := "Enviando dados de 2 objetos...\n---\n" +
+		"|ID: 401, Nome: Helena Rocha Salario: abc|\n---\nEnvio concluído.\n" +
+		"|ID: 402, Nome: Igor Nunes Salario: 3100.50|\n---\nEnvio concluído.\n"
+	streamRange := Control.NewColaboradorInputStream(strings.NewReader(legadoComErro))
+	streamRange.Tolerante = true
+	for colab, err := range streamRange.Todos() {
+		if err != nil {
+			fmt.Printf("  Registro pulado: %v\n", err)
+			continue
+		}
+		fmt.Printf("  %T -> %+v\n", colab, colab)
+	}
+
+	// Sem o modo tolerante, a primeira linha malformada interrompe a leitura.
+	_, err = Control.NewColaboradorInputStream(strings.NewReader(legadoComErro)).LerTodosOsDados()
+	fmt.Printf("  Leitura estrita: %v\n", err)
+	fmt.Println(">>>> FIM DO TESTE 9 <<<<")
+
+	fmt.Println("\n>>>> INICIANDO TESTE 10: NOMES COM DELIMITADORES E QUEBRAS DE LINHA <<<<")
+	nomesDificeis := []Models.Colaborador{
+		Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: 501, Nome: "Ana | Salario: Jr"}, SalarioMensal: 3000},
+		Models.Estagiario{ColaboradorBase: Models.ColaboradorBase{Id: 502, Nome: "Beto; id=999"}, AuxilioEstagio: 900},
+		Models.Autonomo{ColaboradorBase: Models.ColaboradorBase{Id: 503, Nome: "Caio\nSegunda Linha \\ fim"}, ValorHora: 80, HorasTrabalhadas: 10},
+	}
+	for _, formato := range []Control.Formato{Control.FormatoLegado, Control.FormatoV2} {
+		var buf bytes.Buffer
+		streamEscapado := Control.NewColaboradorOutputStream(&buf, nomesDificeis)
+		streamEscapado.Formato = formato
+		if err := streamEscapado.EscreverTodosOsDados(); err != nil {
+			fmt.Printf("Erro no teste 10 ao escrever %s: %v\n", formato, err)
+			continue
+		}
+		fmt.Printf("\nSaída em %s:\n%s", formato, buf.String())
+		dadosEscapados, err := Control.NewColaboradorInputStream(&buf).LerTodosOsDados()
+		if err != nil {
+			fmt.Printf("Erro no teste 10 ao ler %s: %v\n", formato, err)
+			continue
+		}
+		for i, colab := range dadosEscapados {
+			fmt.Printf("  ID %d, Nome: %q, preservado: %t\n", colab.GetId(), colab.GetNome(), colab.GetNome() == nomesDificeis[i].GetNome())
+		}
+	}
+	fmt.Println(">>>> FIM DO TESTE 10 <<<<")
+
+	fmt.Println("\n>>>> INICIANDO TESTE 11: ENVIO ENQUADRADO COM CONFIRMAÇÃO E RETOMADA <<<<")
+	enderecoEnquadrado := "localhost:8083"
+	listenerEnquadrado, err := net.Listen("tcp", enderecoEnquadrado)
+	if err != nil {
+		fmt.Printf("Erro ao iniciar o receptor do teste 11: %v\n", err)
+	} else {
+		receptor := Control.NewReceptorEnquadrado()
+		go func() {
+			for {
+				conn, err := listenerEnquadrado.Accept()
+				if err != nil {
+					return
+				}
+				lote, dados, err := receptor.Receber(conn)
+				conn.Close()
+				if err != nil {
+					fmt.Printf("[RECEPTOR @ Teste 11] Lote %s interrompido: %v (guardados: %d)\n", lote, err, receptor.Recebidos(lote))
+					continue
+				}
+				fmt.Printf("[RECEPTOR @ Teste 11] Lote %s completo com %d colaboradores:\n", lote, len(dados))
+				for _, colab := range dados {
+					fmt.Printf("  %T -> %+v\n", colab, colab)
+				}
+			}
+		}()
+
+		lote := append(append([]Models.Colaborador{}, allColabs...), nomesDificeis...)
+		streamEnquadrado := Control.NewColaboradorOutputStream(nil, lote)
+		for tentativa := 1; tentativa <= 3; tentativa++ {
+			conn, err := net.Dial("tcp", enderecoEnquadrado)
+			if err != nil {
+				fmt.Printf("Erro ao conectar no teste 11: %v\n", err)
+				break
+			}
+			var destino io.ReadWriter = conn
+			if tentativa == 1 {
+				// A primeira conexão cai no meio do terceiro registro.
+				destino = &conexaoInstavel{Conn: conn, restante: 330}
+			}
+			err = streamEnquadrado.EnviarEnquadrado(destino, "folha-2025-06")
+			conn.Close()
+			if err == nil {
+				fmt.Printf("Tentativa %d: lote confirmado (%d de %d)\n", tentativa, streamEnquadrado.Confirmados(), len(lote))
+				break
+			}
+			fmt.Printf("Tentativa %d falhou: %v (confirmados até aqui: %d)\n", tentativa, err, streamEnquadrado.Confirmados())
+			time.Sleep(200 * time.Millisecond)
+		}
+		time.Sleep(200 * time.Millisecond)
+		listenerEnquadrado.Close()
+	}
+
+	// Sem o diálogo, o formato enquadrado também serve para arquivos: a leitura confere
+	// a sequência, o CRC de cada registro e o SHA-256 do trailer.
+	var bufEnquadrado bytes.Buffer
+	streamArquivo := Control.NewColaboradorOutputStream(&bufEnquadrado, allColabs)
+	streamArquivo.Formato = Control.FormatoEnquadrado
+	if err := streamArquivo.EscreverTodosOsDados(); err != nil {
+		fmt.Printf("Erro no teste 11 ao escrever: %v\n", err)
+	} else {
+		fmt.Print(bufEnquadrado.String())
+		adulterado := strings.Replace(bufEnquadrado.String(), "salario_mensal=5000", "salario_mensal=9000", 1)
+		dadosEnquadrados, err := Control.NewColaboradorInputStream(&bufEnquadrado).LerTodosOsDados()
+		fmt.Printf("  Leitura íntegra: %d colaboradores, erro: %v\n", len(dadosEnquadrados), err)
+		_, err = Control.NewColaboradorInputStream(strings.NewReader(adulterado)).LerTodosOsDados()
+		fmt.Printf("  Leitura adulterada: %v\n", err)
+	}
+	fmt.Println(">>>> FIM DO TESTE 11 <<<<")
+
+	fmt.Println("\n>>>> INICIANDO TESTE 12: COMPRESSÃO GZIP <<<<")
+	// Tamanho e vazão de cada formato com e sem compressão: go test -bench . ./Control
+	var bufComprimido bytes.Buffer
+	streamComprimido := Control.NewColaboradorOutputStream(&bufComprimido, allColabs)
+	streamComprimido.Formato = Control.FormatoV2
+	streamComprimido.Compressao = Control.CompressaoGzip
+	if err := streamComprimido.EscreverTodosOsDados(); err != nil {
+		fmt.Printf("Erro no teste 12 ao escrever: %v\n", err)
+	} else {
+		dadosComprimidos, err := Control.NewColaboradorInputStream(&bufComprimido).LerTodosOsDados()
+		fmt.Printf("Leitura de v2 com compressao gzip: %d colaboradores, erro: %v\n", len(dadosComprimidos), err)
+	}
+
+	// Um arquivo .gz comum, sem o cabeçalho de compressão, também é reconhecido.
+	var bufGz bytes.Buffer
+	gz := gzip.NewWriter(&bufGz)
+	streamGz := Control.NewColaboradorOutputStream(gz, allColabs)
+	streamGz.Formato = Control.FormatoNDJSON
+	if err := streamGz.EscreverTodosOsDados(); err == nil && gz.Close() == nil {
+		dadosGz, err := Control.NewColaboradorInputStream(&bufGz).LerTodosOsDados()
+		fmt.Printf("Leitura de NDJSON em .gz puro: %d colaboradores, erro: %v\n", len(dadosGz), err)
+	}
+	fmt.Println(">>>> FIM DO TESTE 12 <<<<")
+}
+
+// conexaoInstavel simula uma queda de conexão depois de um número de bytes escritos.
+type conexaoInstavel struct {
+	net.Conn
+	restante int
+}
+
+func (c *conexaoInstavel) Write(p []byte) (int, error) {
+	if len(p) <= c.restante {
+		c.restante -= len(p)
+		return c.Conn.Write(p)
+	}
+	n, _ := c.Conn.Write(p[:c.restante])
+	c.restante = 0
+	c.Conn.Close()
+	return n, fmt.Errorf("conexao interrompida (simulada)")
+}
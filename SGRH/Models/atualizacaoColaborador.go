@@ -0,0 +1,62 @@
+package Models
+
+import "fmt"
+
+// AtualizacaoColaborador descreve uma alteração parcial: só os campos não nulos são
+// aplicados, e cada campo específico precisa combinar com o tipo do colaborador.
+type AtualizacaoColaborador struct {
+	Nome             *string
+	SalarioMensal    *float64
+	HorasTrabalhadas *int
+	ValorHora        *float64
+	AuxilioEstagio   *float64
+}
+
+// Aplicar devolve uma cópia do colaborador com a atualização aplicada. O original não é alterado.
+func (a AtualizacaoColaborador) Aplicar(c Colaborador) (Colaborador, error) {
+	switch v := c.(type) {
+	case Efetivo:
+		if a.HorasTrabalhadas != nil || a.ValorHora != nil || a.AuxilioEstagio != nil {
+			return nil, fmt.Errorf("atualização inválida: colaborador %d é efetivo e só aceita nome e salário mensal", v.Id)
+		}
+		a.aplicarBase(&v.ColaboradorBase)
+		if a.SalarioMensal != nil {
+			v.SalarioMensal = *a.SalarioMensal
+		}
+		return v, nil
+	case Autonomo:
+		if a.SalarioMensal != nil || a.AuxilioEstagio != nil {
+			return nil, fmt.Errorf("atualização inválida: colaborador %d é autônomo e só aceita nome, horas trabalhadas e valor hora", v.Id)
+		}
+		a.aplicarBase(&v.ColaboradorBase)
+		if a.HorasTrabalhadas != nil {
+			v.HorasTrabalhadas = *a.HorasTrabalhadas
+		}
+		if a.ValorHora != nil {
+			v.ValorHora = *a.ValorHora
+		}
+		return v, nil
+	case Estagiario:
+		if a.SalarioMensal != nil || a.HorasTrabalhadas != nil || a.ValorHora != nil {
+			return nil, fmt.Errorf("atualização inválida: colaborador %d é estagiário e só aceita nome e auxílio estágio", v.Id)
+		}
+		a.aplicarBase(&v.ColaboradorBase)
+		if a.AuxilioEstagio != nil {
+			v.AuxilioEstagio = *a.AuxilioEstagio
+		}
+		return v, nil
+	case StreamedColaborador:
+		if a.SalarioMensal != nil || a.HorasTrabalhadas != nil || a.ValorHora != nil || a.AuxilioEstagio != nil {
+			return nil, fmt.Errorf("atualização inválida: colaborador %d veio de um stream e só aceita nome", v.Id)
+		}
+		a.aplicarBase(&v.ColaboradorBase)
+		return v, nil
+	}
+	return nil, fmt.Errorf("atualização inválida: tipo de colaborador %T não suportado", c)
+}
+
+func (a AtualizacaoColaborador) aplicarBase(base *ColaboradorBase) {
+	if a.Nome != nil {
+		base.Nome = *a.Nome
+	}
+}
@@ -7,8 +7,14 @@ type Departamento struct {
 	Colaboradores []Colaborador
 }
 
-func (d *Departamento) AdicionarColaborador(c Colaborador) {
+func (d *Departamento) AdicionarColaborador(c Colaborador) error {
+	for _, existente := range d.Colaboradores {
+		if existente.GetId() == c.GetId() {
+			return fmt.Errorf("%w: ID %d já existe no departamento '%s'", ErrIDEmUso, c.GetId(), d.Nome)
+		}
+	}
 	d.Colaboradores = append(d.Colaboradores, c)
+	return nil
 }
 
 func (d *Departamento) DemitirColaborador(id int)error {
@@ -27,6 +33,17 @@ func (d *Departamento) DemitirColaborador(id int)error {
 	return nil
 }
 
+// SubstituirColaborador troca o colaborador de mesmo ID pela nova versão, mantendo sua posição.
+func (d *Departamento) SubstituirColaborador(c Colaborador) error {
+	for i := range d.Colaboradores {
+		if d.Colaboradores[i].GetId() == c.GetId() {
+			d.Colaboradores[i] = c
+			return nil
+		}
+	}
+	return fmt.Errorf("atualização falhou: colaborador com ID %d não encontrado no departamento", c.GetId())
+}
+
 func (d *Departamento) CalcularFolhaSalarial() float64 {
 	total := 0.0
 
@@ -0,0 +1,135 @@
+package Models
+
+import "math"
+
+// FaixaINSS é uma faixa da tabela progressiva do INSS: a alíquota incide apenas sobre
+// a parte do salário entre o limite da faixa anterior e Ate.
+type FaixaINSS struct {
+	Ate      float64
+	Aliquota float64
+}
+
+// FaixaIRRF é uma faixa da tabela do IRRF. Ate igual a zero indica a última faixa.
+type FaixaIRRF struct {
+	Ate            float64
+	Aliquota       float64
+	ParcelaDeduzir float64
+}
+
+// TabelasDescontos reúne os parâmetros configuráveis do motor de descontos. Os
+// colaboradores deste servidor não registram dependentes, então o IRRF não tem dedução
+// por dependente.
+type TabelasDescontos struct {
+	INSS         []FaixaINSS // Em ordem crescente; o limite da última faixa é o teto
+	IRRF         []FaixaIRRF // Em ordem crescente
+	AliquotaFGTS float64
+	// AliquotaINSSAutonomo é a contribuição do contribuinte individual, limitada ao teto do INSS.
+	AliquotaINSSAutonomo float64
+}
+
+// TabelasVigentes devolve as tabelas de 2025 (INSS a partir de janeiro, IRRF a partir de maio).
+func TabelasVigentes() TabelasDescontos {
+	return TabelasDescontos{
+		INSS: []FaixaINSS{
+			{Ate: 1518.00, Aliquota: 0.075},
+			{Ate: 2793.88, Aliquota: 0.09},
+			{Ate: 4190.83, Aliquota: 0.12},
+			{Ate: 8157.41, Aliquota: 0.14},
+		},
+		IRRF: []FaixaIRRF{
+			{Ate: 2428.80, Aliquota: 0, ParcelaDeduzir: 0},
+			{Ate: 2826.65, Aliquota: 0.075, ParcelaDeduzir: 182.16},
+			{Ate: 3751.05, Aliquota: 0.15, ParcelaDeduzir: 394.16},
+			{Ate: 4664.68, Aliquota: 0.225, ParcelaDeduzir: 675.49},
+			{Ate: 0, Aliquota: 0.275, ParcelaDeduzir: 908.73},
+		},
+		AliquotaFGTS:         0.08,
+		AliquotaINSSAutonomo: 0.11,
+	}
+}
+
+// MotorDescontos calcula bruto, descontos, líquido e custo do empregador de cada
+// colaborador.
+//
+// Regras por tipo:
+//   - efetivo (CLT): INSS progressivo, IRRF e FGTS como encargo do empregador;
+//   - autônomo: INSS de contribuinte individual sobre o bruto (limitado ao teto) e IRRF;
+//   - estagiário e colaboradores recebidos por stream: apenas IRRF.
+type MotorDescontos struct {
+	Tabelas TabelasDescontos
+}
+
+func NewMotorDescontos(tabelas TabelasDescontos) *MotorDescontos {
+	return &MotorDescontos{Tabelas: tabelas}
+}
+
+// ResumoFolha totaliza a folha de um departamento.
+type ResumoFolha struct {
+	TotalBruto      float64
+	TotalDescontos  float64
+	TotalLiquido    float64
+	CustoEmpregador float64
+}
+
+// ResumirFolha calcula os totais da folha do departamento.
+func (d *Departamento) ResumirFolha(m *MotorDescontos) ResumoFolha {
+	var r ResumoFolha
+	for _, c := range d.Colaboradores {
+		bruto := arredondar(c.CalcularSalario())
+		inss := 0.0
+		encargos := 0.0
+		switch TipoDe(c) {
+		case TipoEfetivo:
+			inss = m.INSS(bruto)
+			encargos = arredondar(bruto * m.Tabelas.AliquotaFGTS)
+		case TipoAutonomo:
+			inss = m.INSSAutonomo(bruto)
+		}
+		descontos := arredondar(inss + m.IRRF(math.Max(0, arredondar(bruto-inss))))
+
+		r.TotalBruto += bruto
+		r.TotalDescontos += descontos
+		r.TotalLiquido += arredondar(bruto - descontos)
+		r.CustoEmpregador += arredondar(bruto + encargos)
+	}
+	r.TotalBruto = arredondar(r.TotalBruto)
+	r.TotalDescontos = arredondar(r.TotalDescontos)
+	r.TotalLiquido = arredondar(r.TotalLiquido)
+	r.CustoEmpregador = arredondar(r.CustoEmpregador)
+	return r
+}
+
+// INSS aplica a tabela progressiva faixa a faixa; acima do teto a contribuição é fixa.
+func (m *MotorDescontos) INSS(salario float64) float64 {
+	total, anterior := 0.0, 0.0
+	for _, f := range m.Tabelas.INSS {
+		if salario <= anterior {
+			break
+		}
+		total += (math.Min(salario, f.Ate) - anterior) * f.Aliquota
+		anterior = f.Ate
+	}
+	return arredondar(total)
+}
+
+func (m *MotorDescontos) INSSAutonomo(bruto float64) float64 {
+	base := bruto
+	if n := len(m.Tabelas.INSS); n > 0 {
+		base = math.Min(base, m.Tabelas.INSS[n-1].Ate)
+	}
+	return arredondar(base * m.Tabelas.AliquotaINSSAutonomo)
+}
+
+// IRRF usa a alíquota da faixa em que a base se encaixa menos a parcela a deduzir.
+func (m *MotorDescontos) IRRF(base float64) float64 {
+	for _, f := range m.Tabelas.IRRF {
+		if f.Ate == 0 || base <= f.Ate {
+			return arredondar(math.Max(0, base*f.Aliquota-f.ParcelaDeduzir))
+		}
+	}
+	return 0
+}
+
+func arredondar(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,85 @@
+package Models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrIDEmUso = errors.New("ID de colaborador já está em uso")
+
+// RegistroIDs garante que cada ID de colaborador pertença a um único departamento em
+// toda a empresa. Também aloca IDs novos quando o cliente não informa um (ID 0).
+type RegistroIDs struct {
+	mu    sync.Mutex
+	donos map[int]string // ID -> nome do departamento
+	maior int
+}
+
+func NewRegistroIDs() *RegistroIDs {
+	return &RegistroIDs{donos: make(map[int]string)}
+}
+
+// Reservar associa o ID ao departamento, falhando se ele já pertencer a alguém.
+func (r *RegistroIDs) Reservar(id int, departamento string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if dono, ok := r.donos[id]; ok {
+		return fmt.Errorf("%w: ID %d já pertence a um colaborador do departamento '%s'", ErrIDEmUso, id, dono)
+	}
+	r.donos[id] = departamento
+	if id > r.maior {
+		r.maior = id
+	}
+	return nil
+}
+
+// Alocar reserva para o departamento um ID acima de todos os já vistos. IDs liberados
+// não são reaproveitados enquanto o processo estiver no ar.
+func (r *RegistroIDs) Alocar(departamento string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maior++
+	r.donos[r.maior] = departamento
+	return r.maior
+}
+
+// Dono devolve o departamento ao qual o ID pertence.
+func (r *RegistroIDs) Dono(id int) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dono, ok := r.donos[id]
+	return dono, ok
+}
+
+func (r *RegistroIDs) Liberar(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.donos, id)
+}
+
+// Mover transfere a posse do ID para outro departamento.
+func (r *RegistroIDs) Mover(id int, departamento string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.donos[id] = departamento
+}
+
+// ComId devolve uma cópia do colaborador com o ID informado.
+func ComId(c Colaborador, id int) (Colaborador, error) {
+	switch v := c.(type) {
+	case Efetivo:
+		v.Id = id
+		return v, nil
+	case Autonomo:
+		v.Id = id
+		return v, nil
+	case Estagiario:
+		v.Id = id
+		return v, nil
+	case StreamedColaborador:
+		v.Id = id
+		return v, nil
+	}
+	return nil, fmt.Errorf("tipo de colaborador %T não suportado", c)
+}
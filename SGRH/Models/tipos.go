@@ -0,0 +1,24 @@
+package Models
+
+// Tipos de colaborador usados nos formatos de stream que preservam o tipo concreto.
+const (
+	TipoEfetivo    = "efetivo"
+	TipoAutonomo   = "autonomo"
+	TipoEstagiario = "estagiario"
+	TipoStreamed   = "streamed"
+)
+
+// TipoDe devolve o tipo do colaborador, aceitando tanto valores quanto ponteiros.
+func TipoDe(c Colaborador) string {
+	switch c.(type) {
+	case Efetivo, *Efetivo:
+		return TipoEfetivo
+	case Autonomo, *Autonomo:
+		return TipoAutonomo
+	case Estagiario, *Estagiario:
+		return TipoEstagiario
+	case StreamedColaborador, *StreamedColaborador:
+		return TipoStreamed
+	}
+	return ""
+}
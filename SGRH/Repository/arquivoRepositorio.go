@@ -0,0 +1,222 @@
+package Repository
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"meu_rh/Models"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	arquivoJournal  = "journal.log"
+	arquivoSnapshot = "snapshot.gob"
+	// tamanhoMaximoRegistro limita o prefixo de tamanho lido do journal; um valor acima
+	// dele só pode vir de um final corrompido e não deve virar uma alocação gigante.
+	tamanhoMaximoRegistro = 16 << 20
+)
+
+var errRegistroGrande = errors.New("registro maior que o tamanho maximo")
+
+type snapshot struct {
+	UltimaSeq     uint64
+	Departamentos []Models.Departamento
+}
+
+// ArquivoRepositorio guarda um journal append-only (uma Operacao por registro,
+// prefixada pelo tamanho) e um snapshot periódico no diretório informado.
+type ArquivoRepositorio struct {
+	Diretorio string
+	journal   *os.File
+	ultimaSeq uint64
+	mu        sync.Mutex
+}
+
+func NewArquivoRepositorio(diretorio string) (*ArquivoRepositorio, error) {
+	if err := os.MkdirAll(diretorio, 0o755); err != nil {
+		return nil, fmt.Errorf("falha ao criar diretorio de dados '%s': %w", diretorio, err)
+	}
+	journal, err := os.OpenFile(filepath.Join(diretorio, arquivoJournal), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao abrir journal: %w", err)
+	}
+	return &ArquivoRepositorio{Diretorio: diretorio, journal: journal}, nil
+}
+
+func (r *ArquivoRepositorio) Carregar() (map[string]*Models.Departamento, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	departamentos := make(map[string]*Models.Departamento)
+
+	snap, err := r.lerSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	for i := range snap.Departamentos {
+		dep := snap.Departamentos[i]
+		departamentos[dep.Nome] = &dep
+	}
+	r.ultimaSeq = snap.UltimaSeq
+
+	if _, err := r.journal.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("falha ao posicionar journal: %w", err)
+	}
+	leitor := bufio.NewReader(r.journal)
+	validos := int64(0)
+	for {
+		op, n, err := lerRegistro(leitor)
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errRegistroGrande) {
+			// Registro incompleto ou com tamanho impossível no final: a escrita foi
+			// interrompida por uma queda.
+			if errTrunc := r.journal.Truncate(validos); errTrunc != nil {
+				return nil, fmt.Errorf("falha ao descartar registro incompleto do journal: %w", errTrunc)
+			}
+			if errSync := r.journal.Sync(); errSync != nil {
+				return nil, fmt.Errorf("falha ao sincronizar journal: %w", errSync)
+			}
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("journal corrompido apos %d bytes: %w", validos, err)
+		}
+		validos += n
+
+		if op.Seq <= snap.UltimaSeq {
+			continue
+		}
+		if err := Aplicar(departamentos, op); err != nil {
+			return nil, fmt.Errorf("falha ao reaplicar operacao %d do journal: %w", op.Seq, err)
+		}
+		r.ultimaSeq = op.Seq
+	}
+
+	return departamentos, nil
+}
+
+func (r *ArquivoRepositorio) Registrar(op Operacao) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	op.Seq = r.ultimaSeq + 1
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(op); err != nil {
+		return fmt.Errorf("falha ao codificar operacao '%s': %w", op.Tipo, err)
+	}
+	if buf.Len() > tamanhoMaximoRegistro {
+		return fmt.Errorf("operacao '%s' com %d bytes: %w", op.Tipo, buf.Len(), errRegistroGrande)
+	}
+	registro := make([]byte, 4+buf.Len())
+	binary.BigEndian.PutUint32(registro, uint32(buf.Len()))
+	copy(registro[4:], buf.Bytes())
+
+	if _, err := r.journal.Write(registro); err != nil {
+		return fmt.Errorf("falha ao escrever no journal: %w", err)
+	}
+	if err := r.journal.Sync(); err != nil {
+		return fmt.Errorf("falha ao sincronizar journal: %w", err)
+	}
+	r.ultimaSeq = op.Seq
+	return nil
+}
+
+func (r *ArquivoRepositorio) Compactar(departamentos map[string]*Models.Departamento) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snap := snapshot{UltimaSeq: r.ultimaSeq}
+	for _, dep := range departamentos {
+		snap.Departamentos = append(snap.Departamentos, Models.Departamento{
+			Nome:          dep.Nome,
+			Colaboradores: append([]Models.Colaborador(nil), dep.Colaboradores...),
+		})
+	}
+
+	caminho := filepath.Join(r.Diretorio, arquivoSnapshot)
+	temporario, err := os.CreateTemp(r.Diretorio, arquivoSnapshot+".*")
+	if err != nil {
+		return fmt.Errorf("falha ao criar snapshot temporario: %w", err)
+	}
+	defer os.Remove(temporario.Name())
+
+	if err := gob.NewEncoder(temporario).Encode(snap); err != nil {
+		temporario.Close()
+		return fmt.Errorf("falha ao codificar snapshot: %w", err)
+	}
+	if err := temporario.Sync(); err != nil {
+		temporario.Close()
+		return fmt.Errorf("falha ao sincronizar snapshot: %w", err)
+	}
+	if err := temporario.Close(); err != nil {
+		return fmt.Errorf("falha ao fechar snapshot: %w", err)
+	}
+	if err := os.Rename(temporario.Name(), caminho); err != nil {
+		return fmt.Errorf("falha ao publicar snapshot: %w", err)
+	}
+
+	// Se cairmos antes do truncate, o replay ignora as entradas com Seq <= UltimaSeq.
+	if err := r.journal.Truncate(0); err != nil {
+		return fmt.Errorf("falha ao truncar journal apos snapshot: %w", err)
+	}
+	if err := r.journal.Sync(); err != nil {
+		return fmt.Errorf("falha ao sincronizar journal: %w", err)
+	}
+	return nil
+}
+
+func (r *ArquivoRepositorio) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.journal.Close()
+}
+
+func (r *ArquivoRepositorio) lerSnapshot() (snapshot, error) {
+	var snap snapshot
+	arquivo, err := os.Open(filepath.Join(r.Diretorio, arquivoSnapshot))
+	if errors.Is(err, os.ErrNotExist) {
+		return snap, nil
+	}
+	if err != nil {
+		return snap, fmt.Errorf("falha ao abrir snapshot: %w", err)
+	}
+	defer arquivo.Close()
+
+	if err := gob.NewDecoder(arquivo).Decode(&snap); err != nil {
+		return snap, fmt.Errorf("falha ao decodificar snapshot: %w", err)
+	}
+	return snap, nil
+}
+
+func lerRegistro(leitor io.Reader) (Operacao, int64, error) {
+	var op Operacao
+	var tamanho uint32
+	if err := binary.Read(leitor, binary.BigEndian, &tamanho); err != nil {
+		return op, 0, err
+	}
+	if tamanho > tamanhoMaximoRegistro {
+		return op, 0, fmt.Errorf("prefixo de %d bytes: %w", tamanho, errRegistroGrande)
+	}
+	dados := make([]byte, tamanho)
+	if _, err := io.ReadFull(leitor, dados); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return op, 0, err
+	}
+	if err := gob.NewDecoder(bytes.NewReader(dados)).Decode(&op); err != nil {
+		return op, 0, fmt.Errorf("registro invalido: %w", err)
+	}
+	return op, int64(4 + tamanho), nil
+}
+
+var _ Repositorio = (*ArquivoRepositorio)(nil)
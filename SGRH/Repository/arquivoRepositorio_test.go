@@ -0,0 +1,62 @@
+package Repository
+
+import (
+	"meu_rh/Models"
+	"testing"
+)
+
+func efetivo(id int, nome string) Models.Colaborador {
+	return Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: id, Nome: nome}, SalarioMensal: 1000}
+}
+
+// gravarJournal registra as operações sem validação, como faziam os servidores
+// anteriores à checagem de IDs duplicados.
+func gravarJournal(t *testing.T, diretorio string, ops ...Operacao) {
+	t.Helper()
+	repo, err := NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	for _, op := range ops {
+		if err := repo.Registrar(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCarregarJournalComIDsDuplicados(t *testing.T) {
+	diretorio := t.TempDir()
+	gravarJournal(t, diretorio,
+		Operacao{Tipo: OpCriarDepartamento, DepartamentoNome: "TI"},
+		Operacao{Tipo: OpAdicionarColaborador, DepartamentoNome: "TI", Colaborador: efetivo(1, "Ana")},
+		Operacao{Tipo: OpAdicionarColaborador, DepartamentoNome: "TI", Colaborador: efetivo(1, "Bruno")},
+		Operacao{Tipo: OpAdicionarColaborador, DepartamentoNome: "RH", Colaborador: efetivo(2, "Carla")},
+		Operacao{Tipo: OpAdicionarColaborador, DepartamentoNome: "TI", Colaborador: efetivo(2, "Davi")},
+		Operacao{Tipo: OpTransferirColaborador, DepartamentoNome: "TI", DepartamentoDestino: "RH", ColaboradorID: 2},
+	)
+
+	repo, err := NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	departamentos, err := repo.Carregar()
+	if err != nil {
+		t.Fatalf("Carregar falhou com journal legado: %v", err)
+	}
+
+	nomes := func(dep string) []string {
+		var n []string
+		for _, c := range departamentos[dep].Colaboradores {
+			n = append(n, c.GetNome())
+		}
+		return n
+	}
+	if got := nomes("TI"); len(got) != 2 || got[0] != "Ana" || got[1] != "Bruno" {
+		t.Errorf("TI = %v, esperava [Ana Bruno]", got)
+	}
+	if got := nomes("RH"); len(got) != 2 || got[0] != "Carla" || got[1] != "Davi" {
+		t.Errorf("RH = %v, esperava [Carla Davi]", got)
+	}
+}
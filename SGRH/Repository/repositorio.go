@@ -0,0 +1,119 @@
+package Repository
+
+import (
+	"errors"
+	"fmt"
+	"meu_rh/Models"
+)
+
+const (
+	OpCriarDepartamento    = "CRIAR_DEPARTAMENTO"
+	OpAdicionarColaborador = "ADICIONAR_COLABORADOR"
+	OpDemitirColaborador   = "DEMITIR_COLABORADOR"
+	// OpAtualizarColaborador grava o colaborador já atualizado por completo, não o delta.
+	OpAtualizarColaborador = "ATUALIZAR_COLABORADOR"
+	// OpTransferirColaborador move o colaborador de DepartamentoNome para DepartamentoDestino
+	// num único registro, para que o replay nunca veja o colaborador fora dos dois departamentos.
+	OpTransferirColaborador = "TRANSFERIR_COLABORADOR"
+)
+
+// Operacao é uma entrada do journal: uma mutação já validada sobre os departamentos.
+type Operacao struct {
+	Seq              uint64
+	Tipo             string
+	DepartamentoNome string
+	Colaborador      Models.Colaborador
+	ColaboradorID    int
+	// DepartamentoDestino só é usado por OpTransferirColaborador.
+	DepartamentoDestino string
+}
+
+// Repositorio persiste as mutações do DepartamentoManager e reconstrói o estado na inicialização.
+type Repositorio interface {
+	// Carregar devolve o estado persistido (snapshot + journal reaplicado).
+	Carregar() (map[string]*Models.Departamento, error)
+	// Registrar grava a operação de forma durável antes de ela ser aplicada em memória.
+	Registrar(op Operacao) error
+	// Compactar grava um snapshot do estado atual e descarta o journal já coberto por ele.
+	Compactar(departamentos map[string]*Models.Departamento) error
+	Close() error
+}
+
+// Aplicar executa a operação sobre o mapa de departamentos. É usada tanto pelo replay
+// do journal quanto por quem precisa manter o estado em memória sincronizado. A operação
+// já deve ter sido validada por quem a registrou; Aplicar não rejeita IDs repetidos.
+func Aplicar(departamentos map[string]*Models.Departamento, op Operacao) error {
+	dep, exists := departamentos[op.DepartamentoNome]
+	if !exists {
+		dep = &Models.Departamento{Nome: op.DepartamentoNome}
+		departamentos[op.DepartamentoNome] = dep
+	}
+
+	switch op.Tipo {
+	case OpCriarDepartamento:
+		return nil
+	case OpAdicionarColaborador:
+		return adicionarJaValidado(dep, op.Colaborador)
+	case OpDemitirColaborador:
+		return dep.DemitirColaborador(op.ColaboradorID)
+	case OpAtualizarColaborador:
+		return dep.SubstituirColaborador(op.Colaborador)
+	case OpTransferirColaborador:
+		destino, exists := departamentos[op.DepartamentoDestino]
+		if !exists {
+			destino = &Models.Departamento{Nome: op.DepartamentoDestino}
+			departamentos[op.DepartamentoDestino] = destino
+		}
+		var colab Models.Colaborador
+		for _, c := range dep.Colaboradores {
+			if c.GetId() == op.ColaboradorID {
+				colab = c
+				break
+			}
+		}
+		if err := dep.DemitirColaborador(op.ColaboradorID); err != nil {
+			return err
+		}
+		return adicionarJaValidado(destino, colab)
+	default:
+		return fmt.Errorf("operação desconhecida no journal: '%s'", op.Tipo)
+	}
+}
+
+// adicionarJaValidado grava o colaborador mesmo que o ID já exista no departamento.
+// Servidores anteriores à checagem de duplicidade gravaram repetições no journal, e o
+// replay precisa reproduzir o estado que eles tinham em vez de recusar a inicialização.
+func adicionarJaValidado(dep *Models.Departamento, c Models.Colaborador) error {
+	err := dep.AdicionarColaborador(c)
+	if !errors.Is(err, Models.ErrIDEmUso) {
+		return err
+	}
+	fmt.Printf("[REPOSITORIO] Aviso ao reaplicar journal: %v\n", err)
+	dep.Colaboradores = append(dep.Colaboradores, c)
+	return nil
+}
+
+// MemoriaRepositorio não persiste nada; mantém o comportamento original do servidor.
+type MemoriaRepositorio struct{}
+
+func NewMemoriaRepositorio() *MemoriaRepositorio {
+	return &MemoriaRepositorio{}
+}
+
+func (m *MemoriaRepositorio) Carregar() (map[string]*Models.Departamento, error) {
+	return make(map[string]*Models.Departamento), nil
+}
+
+func (m *MemoriaRepositorio) Registrar(op Operacao) error {
+	return nil
+}
+
+func (m *MemoriaRepositorio) Compactar(departamentos map[string]*Models.Departamento) error {
+	return nil
+}
+
+func (m *MemoriaRepositorio) Close() error {
+	return nil
+}
+
+var _ Repositorio = (*MemoriaRepositorio)(nil)
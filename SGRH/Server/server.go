@@ -5,32 +5,275 @@ import (
 	"fmt"
 	"io"
 	"meu_rh/Models"
+	"meu_rh/Repository"
 	"meu_rh/Shared"
 	"net"
 	"runtime/debug"
 	"sync"
+	"time"
 )
 
 type DepartamentoManager struct {
 	Departamentos map[string]*Models.Departamento
+	repositorio   Repository.Repositorio
+	ids           *Models.RegistroIDs
+	descontos     *Models.MotorDescontos
 	mu            sync.Mutex
 }
 
 func NewDepartamentoManager() *DepartamentoManager {
 	return &DepartamentoManager{
 		Departamentos: make(map[string]*Models.Departamento),
+		repositorio:   Repository.NewMemoriaRepositorio(),
+		ids:           Models.NewRegistroIDs(),
+		descontos:     Models.NewMotorDescontos(Models.TabelasVigentes()),
 	}
 }
 
-func (dm *DepartamentoManager) GetOrCreateDepartamento(nome string) *Models.Departamento {
+// NewDepartamentoManagerPersistente reconstrói os departamentos a partir do repositório
+// e passa a registrar nele toda mutação antes de aplicá-la em memória.
+func NewDepartamentoManagerPersistente(repositorio Repository.Repositorio) (*DepartamentoManager, error) {
+	departamentos, err := repositorio.Carregar()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar departamentos persistidos: %w", err)
+	}
+	ids := Models.NewRegistroIDs()
+	for nome, dep := range departamentos {
+		for _, c := range dep.Colaboradores {
+			// Dados gravados antes do registro existir podem ter IDs repetidos entre departamentos.
+			if err := ids.Reservar(c.GetId(), nome); err != nil {
+				fmt.Printf("[SERVIDOR] Aviso ao recuperar departamento '%s': %v\n", nome, err)
+			}
+		}
+	}
+	return &DepartamentoManager{
+		Departamentos: departamentos,
+		repositorio:   repositorio,
+		ids:           ids,
+		descontos:     Models.NewMotorDescontos(Models.TabelasVigentes()),
+	}, nil
+}
+
+func (dm *DepartamentoManager) GetOrCreateDepartamento(nome string) (*Models.Departamento, error) {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
+	return dm.getOrCreateDepartamentoLocked(nome)
+}
+
+func (dm *DepartamentoManager) getOrCreateDepartamentoLocked(nome string) (*Models.Departamento, error) {
 	if dep, exists := dm.Departamentos[nome]; exists {
-		return dep
+		return dep, nil
+	}
+	op := Repository.Operacao{Tipo: Repository.OpCriarDepartamento, DepartamentoNome: nome}
+	if err := dm.repositorio.Registrar(op); err != nil {
+		return nil, err
 	}
 	dep := &Models.Departamento{Nome: nome}
 	dm.Departamentos[nome] = dep
-	return dep
+	return dep, nil
+}
+
+// AdicionarColaborador rejeita IDs já usados em qualquer departamento. Colaboradores com
+// ID 0 recebem um ID alocado pelo servidor; o colaborador efetivamente gravado é devolvido.
+func (dm *DepartamentoManager) AdicionarColaborador(deptNome string, colab Models.Colaborador) (Models.Colaborador, error) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dep, err := dm.getOrCreateDepartamentoLocked(deptNome)
+	if err != nil {
+		return nil, err
+	}
+	if colab.GetId() == 0 {
+		colab, err = Models.ComId(colab, dm.ids.Alocar(deptNome))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Validado antes de reservar e de gravar no journal, para que uma recusa não deixe rastro.
+		if possuiColaborador(dep, colab.GetId()) {
+			return nil, fmt.Errorf("%w: ID %d já existe no departamento '%s'", Models.ErrIDEmUso, colab.GetId(), deptNome)
+		}
+		if err := dm.ids.Reservar(colab.GetId(), deptNome); err != nil {
+			return nil, err
+		}
+	}
+
+	op := Repository.Operacao{Tipo: Repository.OpAdicionarColaborador, DepartamentoNome: deptNome, Colaborador: colab}
+	if err := dm.repositorio.Registrar(op); err != nil {
+		dm.ids.Liberar(colab.GetId())
+		return nil, err
+	}
+	if err := dep.AdicionarColaborador(colab); err != nil {
+		return nil, err
+	}
+	return colab, nil
+}
+
+func (dm *DepartamentoManager) DemitirColaborador(deptNome string, colabID int) error {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dep, err := dm.getOrCreateDepartamentoLocked(deptNome)
+	if err != nil {
+		return err
+	}
+	if !possuiColaborador(dep, colabID) {
+		return fmt.Errorf("demissão falhou: colaborador com ID %d não encontrado no departamento", colabID)
+	}
+	op := Repository.Operacao{Tipo: Repository.OpDemitirColaborador, DepartamentoNome: deptNome, ColaboradorID: colabID}
+	if err := dm.repositorio.Registrar(op); err != nil {
+		return err
+	}
+	if err := dep.DemitirColaborador(colabID); err != nil {
+		return err
+	}
+	dm.liberarIDLocked(colabID, deptNome)
+	return nil
+}
+
+// liberarIDLocked solta o ID de um colaborador que saiu do departamento. Journals legados
+// podem repetir um ID entre departamentos: se o departamento não é o dono, o registro
+// fica como está, e se outro departamento ainda tem o ID, a posse passa para ele.
+func (dm *DepartamentoManager) liberarIDLocked(colabID int, deptNome string) {
+	if dono, ok := dm.ids.Dono(colabID); !ok || dono != deptNome {
+		return
+	}
+	for nome, dep := range dm.Departamentos {
+		if possuiColaborador(dep, colabID) {
+			dm.ids.Mover(colabID, nome)
+			return
+		}
+	}
+	dm.ids.Liberar(colabID)
+}
+
+// AtualizarColaborador aplica a atualização parcial e devolve o colaborador resultante.
+func (dm *DepartamentoManager) AtualizarColaborador(deptNome string, colabID int, atualizacao Models.AtualizacaoColaborador) (Models.Colaborador, error) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dep, err := dm.getOrCreateDepartamentoLocked(deptNome)
+	if err != nil {
+		return nil, err
+	}
+	var atual Models.Colaborador
+	for _, c := range dep.Colaboradores {
+		if c.GetId() == colabID {
+			atual = c
+			break
+		}
+	}
+	if atual == nil {
+		return nil, fmt.Errorf("atualização falhou: colaborador com ID %d não encontrado no departamento", colabID)
+	}
+	atualizado, err := atualizacao.Aplicar(atual)
+	if err != nil {
+		return nil, err
+	}
+	op := Repository.Operacao{Tipo: Repository.OpAtualizarColaborador, DepartamentoNome: deptNome, Colaborador: atualizado}
+	if err := dm.repositorio.Registrar(op); err != nil {
+		return nil, err
+	}
+	if err := dep.SubstituirColaborador(atualizado); err != nil {
+		return nil, err
+	}
+	return atualizado, nil
+}
+
+// TransferirColaborador move o colaborador entre departamentos sob um único lock e com um
+// único registro no journal, de modo que ele nunca fique fora dos dois ao mesmo tempo.
+func (dm *DepartamentoManager) TransferirColaborador(origemNome, destinoNome string, colabID int) error {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	if origemNome == destinoNome {
+		return fmt.Errorf("transferência falhou: departamentos de origem e destino são iguais ('%s')", origemNome)
+	}
+	origem, exists := dm.Departamentos[origemNome]
+	if !exists {
+		return fmt.Errorf("transferência falhou: departamento de origem '%s' não encontrado", origemNome)
+	}
+	if !possuiColaborador(origem, colabID) {
+		return fmt.Errorf("transferência falhou: colaborador com ID %d não encontrado no departamento '%s'", colabID, origemNome)
+	}
+	destino, err := dm.getOrCreateDepartamentoLocked(destinoNome)
+	if err != nil {
+		return err
+	}
+	if possuiColaborador(destino, colabID) {
+		return fmt.Errorf("transferência falhou: já existe colaborador com ID %d no departamento '%s'", colabID, destinoNome)
+	}
+
+	op := Repository.Operacao{
+		Tipo:                Repository.OpTransferirColaborador,
+		DepartamentoNome:    origemNome,
+		DepartamentoDestino: destinoNome,
+		ColaboradorID:       colabID,
+	}
+	if err := dm.repositorio.Registrar(op); err != nil {
+		return err
+	}
+	if dono, _ := dm.ids.Dono(colabID); dono == origemNome {
+		dm.ids.Mover(colabID, destinoNome)
+	}
+	return Repository.Aplicar(dm.Departamentos, op)
+}
+
+// CalcularFolhaSalarial devolve os totais bruto, de descontos, líquido e de custo do
+// empregador da folha do departamento.
+func (dm *DepartamentoManager) CalcularFolhaSalarial(deptNome string) (Models.ResumoFolha, error) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dep, err := dm.getOrCreateDepartamentoLocked(deptNome)
+	if err != nil {
+		return Models.ResumoFolha{}, err
+	}
+	return dep.ResumirFolha(dm.descontos), nil
+}
+
+func (dm *DepartamentoManager) ListarColaboradores(deptNome string) ([]Models.Colaborador, error) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	dep, err := dm.getOrCreateDepartamentoLocked(deptNome)
+	if err != nil {
+		return nil, err
+	}
+	return append([]Models.Colaborador(nil), dep.Colaboradores...), nil
+}
+
+// IniciarSnapshots compacta o journal periodicamente até o canal parar ser fechado.
+func (dm *DepartamentoManager) IniciarSnapshots(intervalo time.Duration, parar <-chan struct{}) {
+	ticker := time.NewTicker(intervalo)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := dm.Compactar(); err != nil {
+					fmt.Printf("[SERVIDOR] Falha ao gravar snapshot: %v\n", err)
+				}
+			case <-parar:
+				return
+			}
+		}
+	}()
+}
+
+func (dm *DepartamentoManager) Compactar() error {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return dm.repositorio.Compactar(dm.Departamentos)
+}
+
+func possuiColaborador(dep *Models.Departamento, id int) bool {
+	for _, c := range dep.Colaboradores {
+		if c.GetId() == id {
+			return true
+		}
+	}
+	return false
 }
 
 func handleConnection(conn net.Conn, manager *DepartamentoManager) {
@@ -67,17 +310,18 @@ func handleConnection(conn net.Conn, manager *DepartamentoManager) {
 		switch req.Operation {
 		case Shared.OpAdicionarColaborador:
 			if addData, ok := req.Data.(Shared.AddColaboradorRequestData); ok {
-				dep := manager.GetOrCreateDepartamento(addData.DepartamentoNome)
-				dep.AdicionarColaborador(addData.Colaborador)
-				resp = Shared.Response{Success: true, Message: "Colaborador adicionado"}
+				if colab, err := manager.AdicionarColaborador(addData.DepartamentoNome, addData.Colaborador); err != nil {
+					resp = Shared.Response{Success: false, Message: err.Error()}
+				} else {
+					resp = Shared.Response{Success: true, Message: "Colaborador adicionado", Data: colab}
+				}
 			} else {
 				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para AdicionarColaborador"}
 				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
 			}
 		case Shared.OpDemitirColaborador:
 			if demitirData, ok := req.Data.(Shared.DemitirColaboradorRequestData); ok {
-				dep := manager.GetOrCreateDepartamento(demitirData.DepartamentoNome)
-				err := dep.DemitirColaborador(demitirData.ColaboradorID)
+				err := manager.DemitirColaborador(demitirData.DepartamentoNome, demitirData.ColaboradorID)
 				if err != nil {
 					resp = Shared.Response{Success: false, Message: err.Error()}
 				} else {
@@ -87,19 +331,50 @@ func handleConnection(conn net.Conn, manager *DepartamentoManager) {
 				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para DemitirColaborador"}
 				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
 			}
+		case Shared.OpAtualizarColaborador:
+			if atualizarData, ok := req.Data.(Shared.AtualizarColaboradorRequestData); ok {
+				colab, err := manager.AtualizarColaborador(atualizarData.DepartamentoNome, atualizarData.ColaboradorID, atualizarData.Atualizacao)
+				if err != nil {
+					resp = Shared.Response{Success: false, Message: err.Error()}
+				} else {
+					resp = Shared.Response{Success: true, Message: "Colaborador atualizado", Data: colab}
+				}
+			} else {
+				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para AtualizarColaborador"}
+				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
+			}
+		case Shared.OpTransferirColaborador:
+			if transferirData, ok := req.Data.(Shared.TransferirColaboradorRequestData); ok {
+				err := manager.TransferirColaborador(transferirData.DepartamentoOrigem, transferirData.DepartamentoDestino, transferirData.ColaboradorID)
+				if err != nil {
+					resp = Shared.Response{Success: false, Message: err.Error()}
+				} else {
+					resp = Shared.Response{Success: true, Message: "Colaborador transferido"}
+				}
+			} else {
+				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para TransferirColaborador"}
+				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
+			}
 		case Shared.OpCalcularFolha:
 			if deptData, ok := req.Data.(Shared.DepartamentoRequestData); ok {
-				dep := manager.GetOrCreateDepartamento(deptData.DepartamentoNome)
-				totalFolha := dep.CalcularFolhaSalarial()
-				resp = Shared.Response{Success: true, Data: totalFolha}
+				resumo, err := manager.CalcularFolhaSalarial(deptData.DepartamentoNome)
+				if err != nil {
+					resp = Shared.Response{Success: false, Message: err.Error()}
+				} else {
+					resp = Shared.Response{Success: true, Data: resumo}
+				}
 			} else {
 				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para CalcularFolha"}
 				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
 			}
 		case Shared.OpListarColaboradores:
 			if deptData, ok := req.Data.(Shared.DepartamentoRequestData); ok {
-				dep := manager.GetOrCreateDepartamento(deptData.DepartamentoNome)
-				resp = Shared.Response{Success: true, Data: dep.Colaboradores}
+				colaboradores, err := manager.ListarColaboradores(deptData.DepartamentoNome)
+				if err != nil {
+					resp = Shared.Response{Success: false, Message: err.Error()}
+				} else {
+					resp = Shared.Response{Success: true, Data: colaboradores}
+				}
 			} else {
 				resp = Shared.Response{Success: false, Message: "Tipo de dado invalido para ListarColaboradores"}
 				fmt.Printf("[SERVIDOR %s] ERRO na op '%s': Tipo de dado inválido. Recebido: %T\n", remoteAddr, req.Operation, req.Data)
@@ -118,6 +393,8 @@ func handleConnection(conn net.Conn, manager *DepartamentoManager) {
 
 func main() {
 	endereco := "localhost:8088"
+	diretorioDados := "dados_rh"
+	intervaloSnapshot := 1 * time.Minute
 	listener, err := net.Listen("tcp", endereco)
 	if err != nil {
 		fmt.Printf("[SERVIDOR] Falha fatal ao iniciar servidor: %v\n", err)
@@ -126,7 +403,23 @@ func main() {
 	defer listener.Close()
 	fmt.Printf("[SERVIDOR] Servico de RH Remoto escutando em %s\n", endereco)
 
-	manager := NewDepartamentoManager()
+	repositorio, err := Repository.NewArquivoRepositorio(diretorioDados)
+	if err != nil {
+		fmt.Printf("[SERVIDOR] Falha fatal ao abrir repositorio: %v\n", err)
+		return
+	}
+	defer repositorio.Close()
+
+	manager, err := NewDepartamentoManagerPersistente(repositorio)
+	if err != nil {
+		fmt.Printf("[SERVIDOR] Falha fatal ao recuperar departamentos: %v\n", err)
+		return
+	}
+	fmt.Printf("[SERVIDOR] %d departamento(s) recuperado(s) de '%s'\n", len(manager.Departamentos), diretorioDados)
+
+	parar := make(chan struct{})
+	defer close(parar)
+	manager.IniciarSnapshots(intervaloSnapshot, parar)
 
 	for {
 		conn, err := listener.Accept()
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"errors"
+	"meu_rh/Models"
+	"meu_rh/Repository"
+	"testing"
+)
+
+// Um journal legado com IDs repetidos precisa subir, mas novas requisições com um
+// desses IDs continuam sendo recusadas.
+func TestManagerRecuperaJournalComIDsDuplicados(t *testing.T) {
+	diretorio := t.TempDir()
+	repo, err := Repository.NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	colab := Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: 7, Nome: "Ana"}, SalarioMensal: 1000}
+	for _, dep := range []string{"TI", "TI", "RH"} {
+		op := Repository.Operacao{Tipo: Repository.OpAdicionarColaborador, DepartamentoNome: dep, Colaborador: colab}
+		if err := repo.Registrar(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo.Close()
+
+	repo, err = Repository.NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	manager, err := NewDepartamentoManagerPersistente(repo)
+	if err != nil {
+		t.Fatalf("servidor nao subiu com journal legado: %v", err)
+	}
+	if n := len(manager.Departamentos["TI"].Colaboradores); n != 2 {
+		t.Errorf("TI tem %d colaboradores, esperava 2", n)
+	}
+
+	if _, err := manager.AdicionarColaborador("TI", colab); !errors.Is(err, Models.ErrIDEmUso) {
+		t.Errorf("AdicionarColaborador com ID repetido: err = %v, esperava ErrIDEmUso", err)
+	}
+	if _, err := manager.AdicionarColaborador("Financeiro", colab); !errors.Is(err, Models.ErrIDEmUso) {
+		t.Errorf("AdicionarColaborador com ID de outro departamento: err = %v, esperava ErrIDEmUso", err)
+	}
+}
+
+// Com IDs repetidos no journal, demitir uma das cópias não pode liberar o ID enquanto
+// outro departamento ainda tiver um colaborador com ele.
+func TestManagerSoLiberaIDQuandoNenhumDepartamentoOUsa(t *testing.T) {
+	diretorio := t.TempDir()
+	repo, err := Repository.NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	colab := Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: 7, Nome: "Ana"}, SalarioMensal: 1000}
+	for _, dep := range []string{"TI", "RH"} {
+		op := Repository.Operacao{Tipo: Repository.OpAdicionarColaborador, DepartamentoNome: dep, Colaborador: colab}
+		if err := repo.Registrar(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo.Close()
+
+	repo, err = Repository.NewArquivoRepositorio(diretorio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	manager, err := NewDepartamentoManagerPersistente(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dep := range []string{"RH", "TI"} {
+		if _, err := manager.AdicionarColaborador("Financeiro", colab); !errors.Is(err, Models.ErrIDEmUso) {
+			t.Fatalf("antes de demitir de %s: err = %v, esperava ErrIDEmUso", dep, err)
+		}
+		if err := manager.DemitirColaborador(dep, colab.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := manager.AdicionarColaborador("Financeiro", colab); err != nil {
+		t.Errorf("ID livre depois de demitido de todos os departamentos: %v", err)
+	}
+}
+
+func TestManagerCalcularFolhaSalarialTotaliza(t *testing.T) {
+	manager := NewDepartamentoManager()
+	for _, colab := range []Models.Colaborador{
+		Models.Efetivo{ColaboradorBase: Models.ColaboradorBase{Id: 1, Nome: "Ana"}, SalarioMensal: 9000},
+		Models.Autonomo{ColaboradorBase: Models.ColaboradorBase{Id: 2, Nome: "Bruno"}, ValorHora: 90, HorasTrabalhadas: 40},
+		Models.Estagiario{ColaboradorBase: Models.ColaboradorBase{Id: 3, Nome: "Clara"}, AuxilioEstagio: 1500},
+	} {
+		if _, err := manager.AdicionarColaborador("TI", colab); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resumo, err := manager.CalcularFolhaSalarial("TI")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Efetivo: INSS 951,63 + IRRF 1304,57 e FGTS 720. Autônomo: INSS 396 + IRRF 86,44.
+	// Estagiário: isento.
+	esperado := Models.ResumoFolha{TotalBruto: 14100, TotalDescontos: 2738.64, TotalLiquido: 11361.36, CustoEmpregador: 14820}
+	if resumo != esperado {
+		t.Errorf("resumo = %+v, esperava %+v", resumo, esperado)
+	}
+}
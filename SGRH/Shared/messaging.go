@@ -8,16 +8,21 @@ import (
 func init() {
 	gob.Register(AddColaboradorRequestData{})
 	gob.Register(DemitirColaboradorRequestData{})
+	gob.Register(AtualizarColaboradorRequestData{})
+	gob.Register(TransferirColaboradorRequestData{})
 	gob.Register(DepartamentoRequestData{})
 
 	gob.Register(([]Models.Colaborador)(nil))
+	gob.Register(Models.ResumoFolha{})
 }
 
 const (
-	OpAdicionarColaborador = "ADICIONAR_COLABORADOR"
-	OpDemitirColaborador   = "DEMITIR_COLABORADOR"
-	OpCalcularFolha        = "CALCULAR_FOLHA"
-	OpListarColaboradores  = "LISTAR_COLABORADORES"
+	OpAdicionarColaborador  = "ADICIONAR_COLABORADOR"
+	OpDemitirColaborador    = "DEMITIR_COLABORADOR"
+	OpAtualizarColaborador  = "ATUALIZAR_COLABORADOR"
+	OpTransferirColaborador = "TRANSFERIR_COLABORADOR"
+	OpCalcularFolha         = "CALCULAR_FOLHA"
+	OpListarColaboradores   = "LISTAR_COLABORADORES"
 )
 
 type Request struct {
@@ -43,4 +48,16 @@ type DemitirColaboradorRequestData struct {
 
 type DepartamentoRequestData struct {
 	DepartamentoNome string
-}
\ No newline at end of file
+}
+
+type AtualizarColaboradorRequestData struct {
+	DepartamentoNome string
+	ColaboradorID    int
+	Atualizacao      Models.AtualizacaoColaborador
+}
+
+type TransferirColaboradorRequestData struct {
+	DepartamentoOrigem  string
+	DepartamentoDestino string
+	ColaboradorID       int
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"io"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 )
 
 func main() {
@@ -42,25 +44,88 @@ func main() {
 	}
 	log.Printf("Resposta do servidor: %s", respAdd.Message)
 
-	// 2. Listar Colaboradores
-	log.Println("\n--- Listando Colaboradores em TI ---")
-	respList, err := client.ListarColaboradores(ctx, &pb.ListarColaboradoresRequest{NomeDepartamento: "TI"})
+	// 2. Importar um lote de colaboradores (o ID 301 repetido é rejeitado)
+	log.Println("\n--- Importando Colaboradores em lote ---")
+	lote := []*pb.AddColaboradorRequest{
+		{NomeDepartamento: "TI", Colaborador: &pb.Colaborador{Id: 301, Nome: "Ana Souza", Tipo: pb.TipoColaborador_ESTAGIARIO, DetalhesSalario: &pb.Colaborador_AuxilioEstagio{AuxilioEstagio: 1500.00}}},
+		{NomeDepartamento: "TI", Colaborador: &pb.Colaborador{Id: 302, Nome: "Caio Lima", Tipo: pb.TipoColaborador_AUTONOMO, DetalhesSalario: &pb.Colaborador_Autonomo{Autonomo: &pb.DetalhesAutonomo{ValorHora: 90.00, HorasTrabalhadas: 40}}}},
+		{NomeDepartamento: "TI", Colaborador: &pb.Colaborador{Id: 301, Nome: "Ana Souza", Tipo: pb.TipoColaborador_ESTAGIARIO, DetalhesSalario: &pb.Colaborador_AuxilioEstagio{AuxilioEstagio: 1500.00}}},
+		{NomeDepartamento: "RH", Colaborador: &pb.Colaborador{Id: 401, Nome: "Beatriz Rocha", Tipo: pb.TipoColaborador_EFETIVO, DetalhesSalario: &pb.Colaborador_SalarioMensal{SalarioMensal: 6200.00}}},
+	}
+	respImport, err := importarColaboradores(ctx, client, lote, false)
+	if err != nil {
+		log.Fatalf("Erro ao importar: %v", err)
+	}
+	log.Printf("Importados %d de %d", respImport.TotalImportados, respImport.TotalRecebidos)
+	for _, e := range respImport.Erros {
+		log.Printf("  - Linha %d (depto %s, ID %d): %s", e.Linha, e.NomeDepartamento, e.ColaboradorId, e.Mensagem)
+	}
+
+	// Reenviar o mesmo lote em modo tudo-ou-nada: todos os departamentos com erro são rejeitados
+	respImport, err = importarColaboradores(ctx, client, lote, true)
 	if err != nil {
+		log.Fatalf("Erro ao importar: %v", err)
+	}
+	log.Printf("Tudo-ou-nada: importados %d de %d, departamentos rejeitados: %v", respImport.TotalImportados, respImport.TotalRecebidos, respImport.DepartamentosRejeitados)
+
+	// 3. Atualizar Colaborador (reajuste salarial do efetivo)
+	log.Println("\n--- Atualizando salário do Colaborador ID 201 ---")
+	respAtualizar, err := client.AtualizarColaborador(ctx, &pb.AtualizarColaboradorRequest{NomeDepartamento: "TI", ColaboradorId: 201, SalarioMensal: proto.Float64(8200.00)})
+	if err != nil {
+		log.Fatalf("Erro ao atualizar: %v", err)
+	}
+	log.Printf("Resposta do servidor: %s", respAtualizar.Message)
+
+	// 4. Listar Colaboradores (stream paginado)
+	log.Println("\n--- Listando Colaboradores em TI ---")
+	if err := listarColaboradoresStream(ctx, client, &pb.StreamColaboradoresRequest{NomeDepartamento: "TI", TamanhoPagina: 10}); err != nil {
 		log.Fatalf("Erro ao listar: %v", err)
 	}
-	for _, c := range respList.Colaboradores {
-		log.Printf("  - ID: %d, Nome: %s, Tipo: %s", c.Id, c.Nome, c.Tipo)
+
+	log.Println("\n--- Listando apenas Efetivos em TI ---")
+	filtro := &pb.StreamColaboradoresRequest{NomeDepartamento: "TI", Tipos: []pb.TipoColaborador{pb.TipoColaborador_EFETIVO}}
+	if err := listarColaboradoresStream(ctx, client, filtro); err != nil {
+		log.Fatalf("Erro ao listar: %v", err)
+	}
+
+	// 5. Transferir Colaborador de TI para RH
+	log.Println("\n--- Transferindo Colaborador ID 302 de TI para RH ---")
+	respTransferir, err := client.TransferirColaborador(ctx, &pb.TransferirColaboradorRequest{DepartamentoOrigem: "TI", DepartamentoDestino: "RH", ColaboradorId: 302})
+	if err != nil {
+		log.Fatalf("Erro ao transferir: %v", err)
 	}
+	log.Printf("Resposta do servidor: %s", respTransferir.Message)
 
-	// 3. Calcular Folha Salarial
+	// 6. Calcular Folha Salarial
 	log.Println("\n--- Calculando Folha Salarial de TI ---")
 	respFolha, err := client.CalcularFolhaSalarial(ctx, &pb.CalcularFolhaSalarialRequest{NomeDepartamento: "TI"})
 	if err != nil {
 		log.Fatalf("Erro ao calcular folha: %v", err)
 	}
-	log.Printf("Total da folha: R$ %.2f", respFolha.TotalFolha)
+	log.Printf("Total da folha: bruto R$ %.2f, descontos R$ %.2f, líquido R$ %.2f, custo do empregador R$ %.2f",
+		respFolha.TotalFolha, respFolha.TotalDescontos, respFolha.TotalLiquido, respFolha.CustoEmpregador)
 
-	// 4. Demitir Colaborador
+	// 7. Fechar a folha do mês e consultar o histórico
+	log.Println("\n--- Fechando a Folha de TI ---")
+	competencia := time.Now().Format("2006-01")
+	respFechar, err := client.FecharFolha(ctx, &pb.FecharFolhaRequest{NomeDepartamento: "TI", Competencia: competencia})
+	if err != nil {
+		log.Fatalf("Erro ao fechar folha: %v", err)
+	}
+	if !respFechar.Success {
+		log.Printf("Resposta do servidor: %s", respFechar.Message)
+	} else {
+		exibirFolha(respFechar.Folha)
+	}
+	respFolhas, err := client.ListarFolhas(ctx, &pb.ListarFolhasRequest{NomeDepartamento: "TI"})
+	if err != nil {
+		log.Fatalf("Erro ao listar folhas: %v", err)
+	}
+	for _, f := range respFolhas.Folhas {
+		log.Printf("  - Competência %s fechada em %s: R$ %.2f", f.Competencia, f.FechadaEm, f.Total)
+	}
+
+	// 8. Demitir Colaborador
 	log.Println("\n--- Demitindo Colaborador ID 201 ---")
 	respDemitir, err := client.DemitirColaborador(ctx, &pb.DemitirColaboradorRequest{NomeDepartamento: "TI", ColaboradorId: 201})
 	if err != nil {
@@ -68,7 +133,7 @@ func main() {
 	}
 	log.Printf("Resposta do servidor: %s", respDemitir.Message)
 
-	// 5. Listar novamente para confirmar
+	// 9. Listar novamente para confirmar
 	log.Println("\n--- Listando Colaboradores em TI (após demissão) ---")
 	respList2, err := client.ListarColaboradores(ctx, &pb.ListarColaboradoresRequest{NomeDepartamento: "TI"})
 	if err != nil {
@@ -81,4 +146,62 @@ func main() {
 			log.Printf("  - ID: %d, Nome: %s, Tipo: %s", c.Id, c.Nome, c.Tipo)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func exibirFolha(folha *pb.FolhaPagamento) {
+	log.Printf("Folha %s do depto %s (fechada em %s)", folha.Competencia, folha.NomeDepartamento, folha.FechadaEm)
+	for _, item := range folha.Itens {
+		log.Printf("  - ID: %d, Nome: %s, Tipo: %s, Valor: R$ %.2f", item.ColaboradorId, item.Nome, item.Tipo, item.Valor)
+	}
+	for _, t := range folha.TotaisPorTipo {
+		log.Printf("  Total %s (%d): R$ %.2f", t.Tipo, t.Quantidade, t.Total)
+	}
+	log.Printf("  Total geral: R$ %.2f", folha.Total)
+}
+
+// Consome o stream de páginas, exibindo cada colaborador assim que a página chega
+func listarColaboradoresStream(ctx context.Context, client pb.SGRHClient, req *pb.StreamColaboradoresRequest) error {
+	stream, err := client.StreamColaboradores(ctx, req)
+	if err != nil {
+		return err
+	}
+	total := 0
+	for {
+		pagina, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf(" Página %d (%d colaboradores)", pagina.NumeroPagina, len(pagina.Colaboradores))
+		for _, c := range pagina.Colaboradores {
+			log.Printf("  - ID: %d, Nome: %s, Tipo: %s", c.Id, c.Nome, c.Tipo)
+		}
+		total += len(pagina.Colaboradores)
+	}
+	if total == 0 {
+		log.Println("Nenhum colaborador no departamento.")
+	}
+	return nil
+}
+
+// Envia o lote pelo stream do cliente; com tudoOuNada, um erro descarta o departamento inteiro
+func importarColaboradores(ctx context.Context, client pb.SGRHClient, lote []*pb.AddColaboradorRequest, tudoOuNada bool) (*pb.ImportarColaboradoresResponse, error) {
+	stream, err := client.ImportarColaboradores(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tudoOuNada {
+		opcoes := &pb.OpcoesImportacao{TudoOuNada: true}
+		if err := stream.Send(&pb.ImportarColaboradoresRequest{Conteudo: &pb.ImportarColaboradoresRequest_Opcoes{Opcoes: opcoes}}); err != nil {
+			return nil, err
+		}
+	}
+	for _, req := range lote {
+		if err := stream.Send(&pb.ImportarColaboradoresRequest{Conteudo: &pb.ImportarColaboradoresRequest_Colaborador{Colaborador: req}}); err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
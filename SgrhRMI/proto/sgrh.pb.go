@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
 // source: proto/sgrh.proto
 
 package proto
@@ -355,6 +355,165 @@ func (x *DemitirColaboradorRequest) GetColaboradorId() int32 {
 	return 0
 }
 
+// Campos ausentes mantêm o valor atual; os específicos precisam combinar com o tipo do colaborador
+type AtualizarColaboradorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string   `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	ColaboradorId    int32    `protobuf:"varint,2,opt,name=colaborador_id,json=colaboradorId,proto3" json:"colaborador_id,omitempty"`
+	Nome             *string  `protobuf:"bytes,3,opt,name=nome,proto3,oneof" json:"nome,omitempty"`
+	SalarioMensal    *float64 `protobuf:"fixed64,4,opt,name=salario_mensal,json=salarioMensal,proto3,oneof" json:"salario_mensal,omitempty"`         // Apenas Efetivo
+	ValorHora        *float64 `protobuf:"fixed64,5,opt,name=valor_hora,json=valorHora,proto3,oneof" json:"valor_hora,omitempty"`                     // Apenas Autonomo
+	HorasTrabalhadas *int32   `protobuf:"varint,6,opt,name=horas_trabalhadas,json=horasTrabalhadas,proto3,oneof" json:"horas_trabalhadas,omitempty"` // Apenas Autonomo
+	AuxilioEstagio   *float64 `protobuf:"fixed64,7,opt,name=auxilio_estagio,json=auxilioEstagio,proto3,oneof" json:"auxilio_estagio,omitempty"`      // Apenas Estagiario
+}
+
+func (x *AtualizarColaboradorRequest) Reset() {
+	*x = AtualizarColaboradorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AtualizarColaboradorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtualizarColaboradorRequest) ProtoMessage() {}
+
+func (x *AtualizarColaboradorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtualizarColaboradorRequest.ProtoReflect.Descriptor instead.
+func (*AtualizarColaboradorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AtualizarColaboradorRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+func (x *AtualizarColaboradorRequest) GetColaboradorId() int32 {
+	if x != nil {
+		return x.ColaboradorId
+	}
+	return 0
+}
+
+func (x *AtualizarColaboradorRequest) GetNome() string {
+	if x != nil && x.Nome != nil {
+		return *x.Nome
+	}
+	return ""
+}
+
+func (x *AtualizarColaboradorRequest) GetSalarioMensal() float64 {
+	if x != nil && x.SalarioMensal != nil {
+		return *x.SalarioMensal
+	}
+	return 0
+}
+
+func (x *AtualizarColaboradorRequest) GetValorHora() float64 {
+	if x != nil && x.ValorHora != nil {
+		return *x.ValorHora
+	}
+	return 0
+}
+
+func (x *AtualizarColaboradorRequest) GetHorasTrabalhadas() int32 {
+	if x != nil && x.HorasTrabalhadas != nil {
+		return *x.HorasTrabalhadas
+	}
+	return 0
+}
+
+func (x *AtualizarColaboradorRequest) GetAuxilioEstagio() float64 {
+	if x != nil && x.AuxilioEstagio != nil {
+		return *x.AuxilioEstagio
+	}
+	return 0
+}
+
+type TransferirColaboradorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartamentoOrigem  string `protobuf:"bytes,1,opt,name=departamento_origem,json=departamentoOrigem,proto3" json:"departamento_origem,omitempty"`
+	DepartamentoDestino string `protobuf:"bytes,2,opt,name=departamento_destino,json=departamentoDestino,proto3" json:"departamento_destino,omitempty"`
+	ColaboradorId       int32  `protobuf:"varint,3,opt,name=colaborador_id,json=colaboradorId,proto3" json:"colaborador_id,omitempty"`
+}
+
+func (x *TransferirColaboradorRequest) Reset() {
+	*x = TransferirColaboradorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferirColaboradorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferirColaboradorRequest) ProtoMessage() {}
+
+func (x *TransferirColaboradorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferirColaboradorRequest.ProtoReflect.Descriptor instead.
+func (*TransferirColaboradorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TransferirColaboradorRequest) GetDepartamentoOrigem() string {
+	if x != nil {
+		return x.DepartamentoOrigem
+	}
+	return ""
+}
+
+func (x *TransferirColaboradorRequest) GetDepartamentoDestino() string {
+	if x != nil {
+		return x.DepartamentoDestino
+	}
+	return ""
+}
+
+func (x *TransferirColaboradorRequest) GetColaboradorId() int32 {
+	if x != nil {
+		return x.ColaboradorId
+	}
+	return 0
+}
+
 type ListarColaboradoresRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -366,7 +525,7 @@ type ListarColaboradoresRequest struct {
 func (x *ListarColaboradoresRequest) Reset() {
 	*x = ListarColaboradoresRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_sgrh_proto_msgTypes[4]
+		mi := &file_proto_sgrh_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +538,7 @@ func (x *ListarColaboradoresRequest) String() string {
 func (*ListarColaboradoresRequest) ProtoMessage() {}
 
 func (x *ListarColaboradoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sgrh_proto_msgTypes[4]
+	mi := &file_proto_sgrh_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +551,7 @@ func (x *ListarColaboradoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListarColaboradoresRequest.ProtoReflect.Descriptor instead.
 func (*ListarColaboradoresRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sgrh_proto_rawDescGZIP(), []int{4}
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListarColaboradoresRequest) GetNomeDepartamento() string {
@@ -413,7 +572,7 @@ type ListarColaboradoresResponse struct {
 func (x *ListarColaboradoresResponse) Reset() {
 	*x = ListarColaboradoresResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_sgrh_proto_msgTypes[5]
+		mi := &file_proto_sgrh_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -426,7 +585,173 @@ func (x *ListarColaboradoresResponse) String() string {
 func (*ListarColaboradoresResponse) ProtoMessage() {}
 
 func (x *ListarColaboradoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sgrh_proto_msgTypes[5]
+	mi := &file_proto_sgrh_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListarColaboradoresResponse.ProtoReflect.Descriptor instead.
+func (*ListarColaboradoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListarColaboradoresResponse) GetColaboradores() []*Colaborador {
+	if x != nil {
+		return x.Colaboradores
+	}
+	return nil
+}
+
+type StreamColaboradoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string            `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	TamanhoPagina    int32             `protobuf:"varint,2,opt,name=tamanho_pagina,json=tamanhoPagina,proto3" json:"tamanho_pagina,omitempty"` // 0 usa o tamanho padrão do servidor
+	Tipos            []TipoColaborador `protobuf:"varint,3,rep,packed,name=tipos,proto3,enum=proto.TipoColaborador" json:"tipos,omitempty"`    // Vazio = todos os tipos
+}
+
+func (x *StreamColaboradoresRequest) Reset() {
+	*x = StreamColaboradoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamColaboradoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamColaboradoresRequest) ProtoMessage() {}
+
+func (x *StreamColaboradoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamColaboradoresRequest.ProtoReflect.Descriptor instead.
+func (*StreamColaboradoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamColaboradoresRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+func (x *StreamColaboradoresRequest) GetTamanhoPagina() int32 {
+	if x != nil {
+		return x.TamanhoPagina
+	}
+	return 0
+}
+
+func (x *StreamColaboradoresRequest) GetTipos() []TipoColaborador {
+	if x != nil {
+		return x.Tipos
+	}
+	return nil
+}
+
+// Uma página do stream, em ordem crescente de ID
+type PaginaColaboradores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Colaboradores []*Colaborador `protobuf:"bytes,1,rep,name=colaboradores,proto3" json:"colaboradores,omitempty"`
+	NumeroPagina  int32          `protobuf:"varint,2,opt,name=numero_pagina,json=numeroPagina,proto3" json:"numero_pagina,omitempty"`
+}
+
+func (x *PaginaColaboradores) Reset() {
+	*x = PaginaColaboradores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginaColaboradores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginaColaboradores) ProtoMessage() {}
+
+func (x *PaginaColaboradores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginaColaboradores.ProtoReflect.Descriptor instead.
+func (*PaginaColaboradores) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PaginaColaboradores) GetColaboradores() []*Colaborador {
+	if x != nil {
+		return x.Colaboradores
+	}
+	return nil
+}
+
+func (x *PaginaColaboradores) GetNumeroPagina() int32 {
+	if x != nil {
+		return x.NumeroPagina
+	}
+	return 0
+}
+
+type CalcularFolhaSalarialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+}
+
+func (x *CalcularFolhaSalarialRequest) Reset() {
+	*x = CalcularFolhaSalarialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcularFolhaSalarialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcularFolhaSalarialRequest) ProtoMessage() {}
+
+func (x *CalcularFolhaSalarialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,46 +759,738 @@ func (x *ListarColaboradoresResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcularFolhaSalarialRequest.ProtoReflect.Descriptor instead.
+func (*CalcularFolhaSalarialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CalcularFolhaSalarialRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+type CalcularFolhaSalarialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalFolha      float64 `protobuf:"fixed64,1,opt,name=total_folha,json=totalFolha,proto3" json:"total_folha,omitempty"`             // Bruto
+	TotalDescontos  float64 `protobuf:"fixed64,2,opt,name=total_descontos,json=totalDescontos,proto3" json:"total_descontos,omitempty"` // INSS e IRRF retidos dos colaboradores
+	TotalLiquido    float64 `protobuf:"fixed64,3,opt,name=total_liquido,json=totalLiquido,proto3" json:"total_liquido,omitempty"`
+	CustoEmpregador float64 `protobuf:"fixed64,4,opt,name=custo_empregador,json=custoEmpregador,proto3" json:"custo_empregador,omitempty"` // Bruto mais FGTS
+}
+
+func (x *CalcularFolhaSalarialResponse) Reset() {
+	*x = CalcularFolhaSalarialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcularFolhaSalarialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcularFolhaSalarialResponse) ProtoMessage() {}
+
+func (x *CalcularFolhaSalarialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcularFolhaSalarialResponse.ProtoReflect.Descriptor instead.
+func (*CalcularFolhaSalarialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CalcularFolhaSalarialResponse) GetTotalFolha() float64 {
+	if x != nil {
+		return x.TotalFolha
+	}
+	return 0
+}
+
+func (x *CalcularFolhaSalarialResponse) GetTotalDescontos() float64 {
+	if x != nil {
+		return x.TotalDescontos
+	}
+	return 0
+}
+
+func (x *CalcularFolhaSalarialResponse) GetTotalLiquido() float64 {
+	if x != nil {
+		return x.TotalLiquido
+	}
+	return 0
+}
+
+func (x *CalcularFolhaSalarialResponse) GetCustoEmpregador() float64 {
+	if x != nil {
+		return x.CustoEmpregador
+	}
+	return 0
+}
+
+type FecharFolhaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	Competencia      string `protobuf:"bytes,2,opt,name=competencia,proto3" json:"competencia,omitempty"` // Mês de competência no formato AAAA-MM
+}
+
+func (x *FecharFolhaRequest) Reset() {
+	*x = FecharFolhaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FecharFolhaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FecharFolhaRequest) ProtoMessage() {}
+
+func (x *FecharFolhaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FecharFolhaRequest.ProtoReflect.Descriptor instead.
+func (*FecharFolhaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FecharFolhaRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+func (x *FecharFolhaRequest) GetCompetencia() string {
+	if x != nil {
+		return x.Competencia
+	}
+	return ""
+}
+
+type ListarFolhasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+}
+
+func (x *ListarFolhasRequest) Reset() {
+	*x = ListarFolhasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListarFolhasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListarFolhasRequest) ProtoMessage() {}
+
+func (x *ListarFolhasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListarFolhasRequest.ProtoReflect.Descriptor instead.
+func (*ListarFolhasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListarFolhasRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+type ObterFolhaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	Competencia      string `protobuf:"bytes,2,opt,name=competencia,proto3" json:"competencia,omitempty"`
+}
+
+func (x *ObterFolhaRequest) Reset() {
+	*x = ObterFolhaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObterFolhaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObterFolhaRequest) ProtoMessage() {}
+
+func (x *ObterFolhaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObterFolhaRequest.ProtoReflect.Descriptor instead.
+func (*ObterFolhaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ObterFolhaRequest) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+func (x *ObterFolhaRequest) GetCompetencia() string {
+	if x != nil {
+		return x.Competencia
+	}
+	return ""
+}
+
+// Valor de um colaborador no momento do fechamento
+type ItemFolha struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ColaboradorId int32           `protobuf:"varint,1,opt,name=colaborador_id,json=colaboradorId,proto3" json:"colaborador_id,omitempty"`
+	Nome          string          `protobuf:"bytes,2,opt,name=nome,proto3" json:"nome,omitempty"`
+	Tipo          TipoColaborador `protobuf:"varint,3,opt,name=tipo,proto3,enum=proto.TipoColaborador" json:"tipo,omitempty"`
+	Valor         float64         `protobuf:"fixed64,4,opt,name=valor,proto3" json:"valor,omitempty"`
+}
+
+func (x *ItemFolha) Reset() {
+	*x = ItemFolha{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemFolha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemFolha) ProtoMessage() {}
+
+func (x *ItemFolha) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemFolha.ProtoReflect.Descriptor instead.
+func (*ItemFolha) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ItemFolha) GetColaboradorId() int32 {
+	if x != nil {
+		return x.ColaboradorId
+	}
+	return 0
+}
+
+func (x *ItemFolha) GetNome() string {
+	if x != nil {
+		return x.Nome
+	}
+	return ""
+}
+
+func (x *ItemFolha) GetTipo() TipoColaborador {
+	if x != nil {
+		return x.Tipo
+	}
+	return TipoColaborador_EFETIVO
+}
+
+func (x *ItemFolha) GetValor() float64 {
+	if x != nil {
+		return x.Valor
+	}
+	return 0
+}
+
+type TotalPorTipo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tipo       TipoColaborador `protobuf:"varint,1,opt,name=tipo,proto3,enum=proto.TipoColaborador" json:"tipo,omitempty"`
+	Quantidade int32           `protobuf:"varint,2,opt,name=quantidade,proto3" json:"quantidade,omitempty"`
+	Total      float64         `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *TotalPorTipo) Reset() {
+	*x = TotalPorTipo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotalPorTipo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalPorTipo) ProtoMessage() {}
+
+func (x *TotalPorTipo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalPorTipo.ProtoReflect.Descriptor instead.
+func (*TotalPorTipo) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TotalPorTipo) GetTipo() TipoColaborador {
+	if x != nil {
+		return x.Tipo
+	}
+	return TipoColaborador_EFETIVO
+}
+
+func (x *TotalPorTipo) GetQuantidade() int32 {
+	if x != nil {
+		return x.Quantidade
+	}
+	return 0
+}
+
+func (x *TotalPorTipo) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Folha fechada: não muda mesmo que os colaboradores sejam alterados depois
+type FolhaPagamento struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NomeDepartamento string          `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	Competencia      string          `protobuf:"bytes,2,opt,name=competencia,proto3" json:"competencia,omitempty"`
+	FechadaEm        string          `protobuf:"bytes,3,opt,name=fechada_em,json=fechadaEm,proto3" json:"fechada_em,omitempty"` // RFC 3339
+	Itens            []*ItemFolha    `protobuf:"bytes,4,rep,name=itens,proto3" json:"itens,omitempty"`                          // Em ordem crescente de ID
+	TotaisPorTipo    []*TotalPorTipo `protobuf:"bytes,5,rep,name=totais_por_tipo,json=totaisPorTipo,proto3" json:"totais_por_tipo,omitempty"`
+	Total            float64         `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *FolhaPagamento) Reset() {
+	*x = FolhaPagamento{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolhaPagamento) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolhaPagamento) ProtoMessage() {}
+
+func (x *FolhaPagamento) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolhaPagamento.ProtoReflect.Descriptor instead.
+func (*FolhaPagamento) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FolhaPagamento) GetNomeDepartamento() string {
+	if x != nil {
+		return x.NomeDepartamento
+	}
+	return ""
+}
+
+func (x *FolhaPagamento) GetCompetencia() string {
+	if x != nil {
+		return x.Competencia
+	}
+	return ""
+}
+
+func (x *FolhaPagamento) GetFechadaEm() string {
+	if x != nil {
+		return x.FechadaEm
+	}
+	return ""
+}
+
+func (x *FolhaPagamento) GetItens() []*ItemFolha {
+	if x != nil {
+		return x.Itens
+	}
+	return nil
+}
+
+func (x *FolhaPagamento) GetTotaisPorTipo() []*TotalPorTipo {
+	if x != nil {
+		return x.TotaisPorTipo
+	}
+	return nil
+}
+
+func (x *FolhaPagamento) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type FolhaPagamentoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool            `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Folha   *FolhaPagamento `protobuf:"bytes,3,opt,name=folha,proto3" json:"folha,omitempty"`
+}
+
+func (x *FolhaPagamentoResponse) Reset() {
+	*x = FolhaPagamentoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolhaPagamentoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolhaPagamentoResponse) ProtoMessage() {}
+
+func (x *FolhaPagamentoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolhaPagamentoResponse.ProtoReflect.Descriptor instead.
+func (*FolhaPagamentoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FolhaPagamentoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FolhaPagamentoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FolhaPagamentoResponse) GetFolha() *FolhaPagamento {
+	if x != nil {
+		return x.Folha
+	}
+	return nil
+}
+
+// Folhas em ordem crescente de competência
+type ListarFolhasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folhas []*FolhaPagamento `protobuf:"bytes,1,rep,name=folhas,proto3" json:"folhas,omitempty"`
+}
+
+func (x *ListarFolhasResponse) Reset() {
+	*x = ListarFolhasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListarFolhasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListarFolhasResponse) ProtoMessage() {}
+
+func (x *ListarFolhasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListarFolhasResponse.ProtoReflect.Descriptor instead.
+func (*ListarFolhasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListarFolhasResponse) GetFolhas() []*FolhaPagamento {
+	if x != nil {
+		return x.Folhas
+	}
+	return nil
+}
+
+type OpcoesImportacao struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TudoOuNada bool `protobuf:"varint,1,opt,name=tudo_ou_nada,json=tudoOuNada,proto3" json:"tudo_ou_nada,omitempty"` // Um erro descarta o departamento inteiro
+}
+
+func (x *OpcoesImportacao) Reset() {
+	*x = OpcoesImportacao{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpcoesImportacao) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpcoesImportacao) ProtoMessage() {}
+
+func (x *OpcoesImportacao) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpcoesImportacao.ProtoReflect.Descriptor instead.
+func (*OpcoesImportacao) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OpcoesImportacao) GetTudoOuNada() bool {
+	if x != nil {
+		return x.TudoOuNada
+	}
+	return false
+}
+
+type ImportarColaboradoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Conteudo:
+	//	*ImportarColaboradoresRequest_Opcoes
+	//	*ImportarColaboradoresRequest_Colaborador
+	Conteudo isImportarColaboradoresRequest_Conteudo `protobuf_oneof:"conteudo"`
+}
+
+func (x *ImportarColaboradoresRequest) Reset() {
+	*x = ImportarColaboradoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_sgrh_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportarColaboradoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportarColaboradoresRequest) ProtoMessage() {}
+
+func (x *ImportarColaboradoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportarColaboradoresRequest.ProtoReflect.Descriptor instead.
+func (*ImportarColaboradoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *ImportarColaboradoresRequest) GetConteudo() isImportarColaboradoresRequest_Conteudo {
+	if m != nil {
+		return m.Conteudo
+	}
+	return nil
+}
+
+func (x *ImportarColaboradoresRequest) GetOpcoes() *OpcoesImportacao {
+	if x, ok := x.GetConteudo().(*ImportarColaboradoresRequest_Opcoes); ok {
+		return x.Opcoes
+	}
+	return nil
+}
+
+func (x *ImportarColaboradoresRequest) GetColaborador() *AddColaboradorRequest {
+	if x, ok := x.GetConteudo().(*ImportarColaboradoresRequest_Colaborador); ok {
+		return x.Colaborador
+	}
+	return nil
 }
 
-// Deprecated: Use ListarColaboradoresResponse.ProtoReflect.Descriptor instead.
-func (*ListarColaboradoresResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sgrh_proto_rawDescGZIP(), []int{5}
+type isImportarColaboradoresRequest_Conteudo interface {
+	isImportarColaboradoresRequest_Conteudo()
 }
 
-func (x *ListarColaboradoresResponse) GetColaboradores() []*Colaborador {
-	if x != nil {
-		return x.Colaboradores
-	}
-	return nil
+type ImportarColaboradoresRequest_Opcoes struct {
+	Opcoes *OpcoesImportacao `protobuf:"bytes,1,opt,name=opcoes,proto3,oneof"` // Só é aceita como primeira mensagem do stream
 }
 
-type CalcularFolhaSalarialRequest struct {
+type ImportarColaboradoresRequest_Colaborador struct {
+	Colaborador *AddColaboradorRequest `protobuf:"bytes,2,opt,name=colaborador,proto3,oneof"`
+}
+
+func (*ImportarColaboradoresRequest_Opcoes) isImportarColaboradoresRequest_Conteudo() {}
+
+func (*ImportarColaboradoresRequest_Colaborador) isImportarColaboradoresRequest_Conteudo() {}
+
+type ErroImportacao struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NomeDepartamento string `protobuf:"bytes,1,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	Linha            int32  `protobuf:"varint,1,opt,name=linha,proto3" json:"linha,omitempty"` // Posição no stream, começando em 1
+	NomeDepartamento string `protobuf:"bytes,2,opt,name=nome_departamento,json=nomeDepartamento,proto3" json:"nome_departamento,omitempty"`
+	ColaboradorId    int32  `protobuf:"varint,3,opt,name=colaborador_id,json=colaboradorId,proto3" json:"colaborador_id,omitempty"`
+	Mensagem         string `protobuf:"bytes,4,opt,name=mensagem,proto3" json:"mensagem,omitempty"`
 }
 
-func (x *CalcularFolhaSalarialRequest) Reset() {
-	*x = CalcularFolhaSalarialRequest{}
+func (x *ErroImportacao) Reset() {
+	*x = ErroImportacao{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_sgrh_proto_msgTypes[6]
+		mi := &file_proto_sgrh_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CalcularFolhaSalarialRequest) String() string {
+func (x *ErroImportacao) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalcularFolhaSalarialRequest) ProtoMessage() {}
+func (*ErroImportacao) ProtoMessage() {}
 
-func (x *CalcularFolhaSalarialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sgrh_proto_msgTypes[6]
+func (x *ErroImportacao) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,43 +1501,67 @@ func (x *CalcularFolhaSalarialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalcularFolhaSalarialRequest.ProtoReflect.Descriptor instead.
-func (*CalcularFolhaSalarialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sgrh_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ErroImportacao.ProtoReflect.Descriptor instead.
+func (*ErroImportacao) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CalcularFolhaSalarialRequest) GetNomeDepartamento() string {
+func (x *ErroImportacao) GetLinha() int32 {
+	if x != nil {
+		return x.Linha
+	}
+	return 0
+}
+
+func (x *ErroImportacao) GetNomeDepartamento() string {
 	if x != nil {
 		return x.NomeDepartamento
 	}
 	return ""
 }
 
-type CalcularFolhaSalarialResponse struct {
+func (x *ErroImportacao) GetColaboradorId() int32 {
+	if x != nil {
+		return x.ColaboradorId
+	}
+	return 0
+}
+
+func (x *ErroImportacao) GetMensagem() string {
+	if x != nil {
+		return x.Mensagem
+	}
+	return ""
+}
+
+type ImportarColaboradoresResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalFolha float64 `protobuf:"fixed64,1,opt,name=total_folha,json=totalFolha,proto3" json:"total_folha,omitempty"`
+	TotalRecebidos          int32             `protobuf:"varint,1,opt,name=total_recebidos,json=totalRecebidos,proto3" json:"total_recebidos,omitempty"`
+	TotalImportados         int32             `protobuf:"varint,2,opt,name=total_importados,json=totalImportados,proto3" json:"total_importados,omitempty"`
+	Erros                   []*ErroImportacao `protobuf:"bytes,3,rep,name=erros,proto3" json:"erros,omitempty"`
+	DepartamentosRejeitados []string          `protobuf:"bytes,4,rep,name=departamentos_rejeitados,json=departamentosRejeitados,proto3" json:"departamentos_rejeitados,omitempty"` // Apenas no modo tudo-ou-nada
 }
 
-func (x *CalcularFolhaSalarialResponse) Reset() {
-	*x = CalcularFolhaSalarialResponse{}
+func (x *ImportarColaboradoresResponse) Reset() {
+	*x = ImportarColaboradoresResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_sgrh_proto_msgTypes[7]
+		mi := &file_proto_sgrh_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CalcularFolhaSalarialResponse) String() string {
+func (x *ImportarColaboradoresResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalcularFolhaSalarialResponse) ProtoMessage() {}
+func (*ImportarColaboradoresResponse) ProtoMessage() {}
 
-func (x *CalcularFolhaSalarialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sgrh_proto_msgTypes[7]
+func (x *ImportarColaboradoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sgrh_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,18 +1572,39 @@ func (x *CalcularFolhaSalarialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalcularFolhaSalarialResponse.ProtoReflect.Descriptor instead.
-func (*CalcularFolhaSalarialResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sgrh_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportarColaboradoresResponse.ProtoReflect.Descriptor instead.
+func (*ImportarColaboradoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CalcularFolhaSalarialResponse) GetTotalFolha() float64 {
+func (x *ImportarColaboradoresResponse) GetTotalRecebidos() int32 {
 	if x != nil {
-		return x.TotalFolha
+		return x.TotalRecebidos
+	}
+	return 0
+}
+
+func (x *ImportarColaboradoresResponse) GetTotalImportados() int32 {
+	if x != nil {
+		return x.TotalImportados
 	}
 	return 0
 }
 
+func (x *ImportarColaboradoresResponse) GetErros() []*ErroImportacao {
+	if x != nil {
+		return x.Erros
+	}
+	return nil
+}
+
+func (x *ImportarColaboradoresResponse) GetDepartamentosRejeitados() []string {
+	if x != nil {
+		return x.DepartamentosRejeitados
+	}
+	return nil
+}
+
 // Resposta genérica para operações simples
 type SGRHResponse struct {
 	state         protoimpl.MessageState
@@ -556,7 +1618,7 @@ type SGRHResponse struct {
 func (x *SGRHResponse) Reset() {
 	*x = SGRHResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_sgrh_proto_msgTypes[8]
+		mi := &file_proto_sgrh_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +1631,7 @@ func (x *SGRHResponse) String() string {
 func (*SGRHResponse) ProtoMessage() {}
 
 func (x *SGRHResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sgrh_proto_msgTypes[8]
+	mi := &file_proto_sgrh_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +1644,7 @@ func (x *SGRHResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SGRHResponse.ProtoReflect.Descriptor instead.
 func (*SGRHResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sgrh_proto_rawDescGZIP(), []int{8}
+	return file_proto_sgrh_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SGRHResponse) GetSuccess() bool {
@@ -640,58 +1702,253 @@ var file_proto_sgrh_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x12, 0x25,
 	0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
-	0x64, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43,
-	0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
-	0x22, 0x57, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
-	0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x8f, 0x03, 0x0a, 0x1b, 0x41, 0x74, 0x75, 0x61, 0x6c, 0x69,
+	0x7a, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x6f, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x6d, 0x65,
+	0x6e, 0x73, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x73, 0x61,
+	0x6c, 0x61, 0x72, 0x69, 0x6f, 0x4d, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x6f, 0x72, 0x5f, 0x68, 0x6f, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x02, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x6f, 0x72, 0x48, 0x6f, 0x72, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x68, 0x6f, 0x72, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x62,
+	0x61, 0x6c, 0x68, 0x61, 0x64, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52,
+	0x10, 0x68, 0x6f, 0x72, 0x61, 0x73, 0x54, 0x72, 0x61, 0x62, 0x61, 0x6c, 0x68, 0x61, 0x64, 0x61,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x6f, 0x5f,
+	0x65, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x0e, 0x61, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x6f, 0x45, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6f, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x73, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x6f, 0x5f, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x6f, 0x72, 0x5f, 0x68, 0x6f, 0x72, 0x61, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x68, 0x6f, 0x72, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x62, 0x61, 0x6c, 0x68, 0x61,
+	0x64, 0x61, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x75, 0x78, 0x69, 0x6c, 0x69, 0x6f, 0x5f,
+	0x65, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x6f, 0x4f, 0x72, 0x69, 0x67, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f,
+	0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x22, 0x57,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x6d, 0x61, 0x6e, 0x68, 0x6f, 0x5f, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x61, 0x6d,
+	0x61, 0x6e, 0x68, 0x6f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x69,
+	0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x69, 0x70, 0x6f, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x52, 0x05, 0x74, 0x69, 0x70, 0x6f, 0x73, 0x22, 0x74, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12,
 	0x38, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
 	0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61,
-	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d,
-	0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x1d, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
-	0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x66, 0x6f, 0x6c, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x22, 0x42, 0x0a, 0x0c, 0x53, 0x47, 0x52, 0x48,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x3c, 0x0a, 0x0f,
-	0x54, 0x69, 0x70, 0x6f, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12,
-	0x0b, 0x0a, 0x07, 0x45, 0x46, 0x45, 0x54, 0x49, 0x56, 0x4f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x41, 0x55, 0x54, 0x4f, 0x4e, 0x4f, 0x4d, 0x4f, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x53,
-	0x54, 0x41, 0x47, 0x49, 0x41, 0x52, 0x49, 0x4f, 0x10, 0x02, 0x32, 0xe8, 0x02, 0x0a, 0x04, 0x53,
-	0x47, 0x52, 0x48, 0x12, 0x4b, 0x0a, 0x14, 0x41, 0x64, 0x69, 0x63, 0x69, 0x6f, 0x6e, 0x61, 0x72,
-	0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
-	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4d, 0x0a, 0x12, 0x44, 0x65, 0x6d, 0x69, 0x74, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62,
-	0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x6d, 0x69, 0x74, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72,
-	0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
-	0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x64, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61,
-	0x53, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61,
-	0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f,
-	0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a, 0x53, 0x47, 0x52, 0x48, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x6f, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x6f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x22, 0x4b,
+	0x0a, 0x1c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53,
+	0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x1d,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c,
+	0x61, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x6c, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x6f,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65,
+	0x73, 0x63, 0x6f, 0x6e, 0x74, 0x6f, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x6f, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x5f, 0x65, 0x6d, 0x70, 0x72, 0x65, 0x67, 0x61, 0x64, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x45, 0x6d, 0x70,
+	0x72, 0x65, 0x67, 0x61, 0x64, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x12, 0x46, 0x65, 0x63, 0x68, 0x61,
+	0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x61, 0x22, 0x42, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f,
+	0x22, 0x62, 0x0a, 0x11, 0x4f, 0x62, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65,
+	0x6e, 0x63, 0x69, 0x61, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x6f, 0x6c,
+	0x68, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x04, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x70, 0x6f, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x64, 0x6f, 0x72, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x6f, 0x72, 0x22,
+	0x70, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x6f, 0x12,
+	0x2a, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x69, 0x70, 0x6f, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x04, 0x74, 0x69, 0x70, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x64, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x64, 0x61, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0xf9, 0x01, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61, 0x67, 0x61, 0x6d,
+	0x65, 0x6e, 0x74, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6e, 0x6f, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x65, 0x6e,
+	0x63, 0x69, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x63, 0x68, 0x61, 0x64, 0x61, 0x5f, 0x65,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x63, 0x68, 0x61, 0x64, 0x61,
+	0x45, 0x6d, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x6f,
+	0x6c, 0x68, 0x61, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x70, 0x6f, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x6f, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x69, 0x73,
+	0x50, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x79, 0x0a,
+	0x16, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61, 0x67, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x66,
+	0x6f, 0x6c, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61, 0x67, 0x61, 0x6d, 0x65, 0x6e, 0x74,
+	0x6f, 0x52, 0x05, 0x66, 0x6f, 0x6c, 0x68, 0x61, 0x22, 0x45, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61,
+	0x67, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x22,
+	0x34, 0x0a, 0x10, 0x4f, 0x70, 0x63, 0x6f, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x63, 0x61, 0x6f, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x75, 0x64, 0x6f, 0x5f, 0x6f, 0x75, 0x5f, 0x6e,
+	0x61, 0x64, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x75, 0x64, 0x6f, 0x4f,
+	0x75, 0x4e, 0x61, 0x64, 0x61, 0x22, 0x9f, 0x01, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f,
+	0x70, 0x63, 0x6f, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x63, 0x61, 0x6f, 0x48,
+	0x00, 0x52, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x75, 0x64, 0x6f, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x45, 0x72, 0x72, 0x6f,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x63, 0x61, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6e, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x68, 0x61,
+	0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61,
+	0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6d,
+	0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x67, 0x65, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x73, 0x61, 0x67, 0x65, 0x6d,
+	0x22, 0xdb, 0x01, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x62, 0x69, 0x64, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x62, 0x69, 0x64, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x63, 0x61, 0x6f, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x6f, 0x73, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x69, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x61, 0x6d, 0x65,
+	0x6e, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x69, 0x74, 0x61, 0x64, 0x6f, 0x73, 0x22, 0x42,
+	0x0a, 0x0c, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x3c, 0x0a, 0x0f, 0x54, 0x69, 0x70, 0x6f, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f,
+	0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x46, 0x45, 0x54, 0x49, 0x56, 0x4f,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x55, 0x54, 0x4f, 0x4e, 0x4f, 0x4d, 0x4f, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x53, 0x54, 0x41, 0x47, 0x49, 0x41, 0x52, 0x49, 0x4f, 0x10, 0x02,
+	0x32, 0xb1, 0x07, 0x0a, 0x04, 0x53, 0x47, 0x52, 0x48, 0x12, 0x4b, 0x0a, 0x14, 0x41, 0x64, 0x69,
+	0x63, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x44, 0x65, 0x6d, 0x69, 0x74, 0x69,
+	0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6d, 0x69, 0x74, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61,
+	0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x14, 0x41, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x12, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x72, 0x43,
+	0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x47, 0x52, 0x48, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x47, 0x52, 0x48, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x64, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c,
+	0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x53, 0x61, 0x6c, 0x61, 0x72,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0b, 0x46, 0x65, 0x63, 0x68, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x63, 0x68, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61, 0x67, 0x61, 0x6d, 0x65, 0x6e, 0x74, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x61, 0x72, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4f, 0x62, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x68,
+	0x61, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x74, 0x65, 0x72, 0x46,
+	0x6f, 0x6c, 0x68, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x6c, 0x68, 0x61, 0x50, 0x61, 0x67, 0x61, 0x6d, 0x65, 0x6e,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
+	0x64, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x64, 0x6f,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x72, 0x43, 0x6f, 0x6c, 0x61, 0x62,
+	0x6f, 0x72, 0x61, 0x64, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x42, 0x0c, 0x5a, 0x0a, 0x53, 0x47, 0x52, 0x48, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -707,37 +1964,78 @@ func file_proto_sgrh_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_sgrh_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_sgrh_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_sgrh_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_proto_sgrh_proto_goTypes = []interface{}{
 	(TipoColaborador)(0),                  // 0: proto.TipoColaborador
 	(*Colaborador)(nil),                   // 1: proto.Colaborador
 	(*DetalhesAutonomo)(nil),              // 2: proto.DetalhesAutonomo
 	(*AddColaboradorRequest)(nil),         // 3: proto.AddColaboradorRequest
 	(*DemitirColaboradorRequest)(nil),     // 4: proto.DemitirColaboradorRequest
-	(*ListarColaboradoresRequest)(nil),    // 5: proto.ListarColaboradoresRequest
-	(*ListarColaboradoresResponse)(nil),   // 6: proto.ListarColaboradoresResponse
-	(*CalcularFolhaSalarialRequest)(nil),  // 7: proto.CalcularFolhaSalarialRequest
-	(*CalcularFolhaSalarialResponse)(nil), // 8: proto.CalcularFolhaSalarialResponse
-	(*SGRHResponse)(nil),                  // 9: proto.SGRHResponse
+	(*AtualizarColaboradorRequest)(nil),   // 5: proto.AtualizarColaboradorRequest
+	(*TransferirColaboradorRequest)(nil),  // 6: proto.TransferirColaboradorRequest
+	(*ListarColaboradoresRequest)(nil),    // 7: proto.ListarColaboradoresRequest
+	(*ListarColaboradoresResponse)(nil),   // 8: proto.ListarColaboradoresResponse
+	(*StreamColaboradoresRequest)(nil),    // 9: proto.StreamColaboradoresRequest
+	(*PaginaColaboradores)(nil),           // 10: proto.PaginaColaboradores
+	(*CalcularFolhaSalarialRequest)(nil),  // 11: proto.CalcularFolhaSalarialRequest
+	(*CalcularFolhaSalarialResponse)(nil), // 12: proto.CalcularFolhaSalarialResponse
+	(*FecharFolhaRequest)(nil),            // 13: proto.FecharFolhaRequest
+	(*ListarFolhasRequest)(nil),           // 14: proto.ListarFolhasRequest
+	(*ObterFolhaRequest)(nil),             // 15: proto.ObterFolhaRequest
+	(*ItemFolha)(nil),                     // 16: proto.ItemFolha
+	(*TotalPorTipo)(nil),                  // 17: proto.TotalPorTipo
+	(*FolhaPagamento)(nil),                // 18: proto.FolhaPagamento
+	(*FolhaPagamentoResponse)(nil),        // 19: proto.FolhaPagamentoResponse
+	(*ListarFolhasResponse)(nil),          // 20: proto.ListarFolhasResponse
+	(*OpcoesImportacao)(nil),              // 21: proto.OpcoesImportacao
+	(*ImportarColaboradoresRequest)(nil),  // 22: proto.ImportarColaboradoresRequest
+	(*ErroImportacao)(nil),                // 23: proto.ErroImportacao
+	(*ImportarColaboradoresResponse)(nil), // 24: proto.ImportarColaboradoresResponse
+	(*SGRHResponse)(nil),                  // 25: proto.SGRHResponse
 }
 var file_proto_sgrh_proto_depIdxs = []int32{
-	0, // 0: proto.Colaborador.tipo:type_name -> proto.TipoColaborador
-	2, // 1: proto.Colaborador.autonomo:type_name -> proto.DetalhesAutonomo
-	1, // 2: proto.AddColaboradorRequest.colaborador:type_name -> proto.Colaborador
-	1, // 3: proto.ListarColaboradoresResponse.colaboradores:type_name -> proto.Colaborador
-	3, // 4: proto.SGRH.AdicionarColaborador:input_type -> proto.AddColaboradorRequest
-	4, // 5: proto.SGRH.DemitirColaborador:input_type -> proto.DemitirColaboradorRequest
-	5, // 6: proto.SGRH.ListarColaboradores:input_type -> proto.ListarColaboradoresRequest
-	7, // 7: proto.SGRH.CalcularFolhaSalarial:input_type -> proto.CalcularFolhaSalarialRequest
-	9, // 8: proto.SGRH.AdicionarColaborador:output_type -> proto.SGRHResponse
-	9, // 9: proto.SGRH.DemitirColaborador:output_type -> proto.SGRHResponse
-	6, // 10: proto.SGRH.ListarColaboradores:output_type -> proto.ListarColaboradoresResponse
-	8, // 11: proto.SGRH.CalcularFolhaSalarial:output_type -> proto.CalcularFolhaSalarialResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: proto.Colaborador.tipo:type_name -> proto.TipoColaborador
+	2,  // 1: proto.Colaborador.autonomo:type_name -> proto.DetalhesAutonomo
+	1,  // 2: proto.AddColaboradorRequest.colaborador:type_name -> proto.Colaborador
+	1,  // 3: proto.ListarColaboradoresResponse.colaboradores:type_name -> proto.Colaborador
+	0,  // 4: proto.StreamColaboradoresRequest.tipos:type_name -> proto.TipoColaborador
+	1,  // 5: proto.PaginaColaboradores.colaboradores:type_name -> proto.Colaborador
+	0,  // 6: proto.ItemFolha.tipo:type_name -> proto.TipoColaborador
+	0,  // 7: proto.TotalPorTipo.tipo:type_name -> proto.TipoColaborador
+	16, // 8: proto.FolhaPagamento.itens:type_name -> proto.ItemFolha
+	17, // 9: proto.FolhaPagamento.totais_por_tipo:type_name -> proto.TotalPorTipo
+	18, // 10: proto.FolhaPagamentoResponse.folha:type_name -> proto.FolhaPagamento
+	18, // 11: proto.ListarFolhasResponse.folhas:type_name -> proto.FolhaPagamento
+	21, // 12: proto.ImportarColaboradoresRequest.opcoes:type_name -> proto.OpcoesImportacao
+	3,  // 13: proto.ImportarColaboradoresRequest.colaborador:type_name -> proto.AddColaboradorRequest
+	23, // 14: proto.ImportarColaboradoresResponse.erros:type_name -> proto.ErroImportacao
+	3,  // 15: proto.SGRH.AdicionarColaborador:input_type -> proto.AddColaboradorRequest
+	4,  // 16: proto.SGRH.DemitirColaborador:input_type -> proto.DemitirColaboradorRequest
+	5,  // 17: proto.SGRH.AtualizarColaborador:input_type -> proto.AtualizarColaboradorRequest
+	6,  // 18: proto.SGRH.TransferirColaborador:input_type -> proto.TransferirColaboradorRequest
+	7,  // 19: proto.SGRH.ListarColaboradores:input_type -> proto.ListarColaboradoresRequest
+	9,  // 20: proto.SGRH.StreamColaboradores:input_type -> proto.StreamColaboradoresRequest
+	11, // 21: proto.SGRH.CalcularFolhaSalarial:input_type -> proto.CalcularFolhaSalarialRequest
+	13, // 22: proto.SGRH.FecharFolha:input_type -> proto.FecharFolhaRequest
+	14, // 23: proto.SGRH.ListarFolhas:input_type -> proto.ListarFolhasRequest
+	15, // 24: proto.SGRH.ObterFolha:input_type -> proto.ObterFolhaRequest
+	22, // 25: proto.SGRH.ImportarColaboradores:input_type -> proto.ImportarColaboradoresRequest
+	25, // 26: proto.SGRH.AdicionarColaborador:output_type -> proto.SGRHResponse
+	25, // 27: proto.SGRH.DemitirColaborador:output_type -> proto.SGRHResponse
+	25, // 28: proto.SGRH.AtualizarColaborador:output_type -> proto.SGRHResponse
+	25, // 29: proto.SGRH.TransferirColaborador:output_type -> proto.SGRHResponse
+	8,  // 30: proto.SGRH.ListarColaboradores:output_type -> proto.ListarColaboradoresResponse
+	10, // 31: proto.SGRH.StreamColaboradores:output_type -> proto.PaginaColaboradores
+	12, // 32: proto.SGRH.CalcularFolhaSalarial:output_type -> proto.CalcularFolhaSalarialResponse
+	19, // 33: proto.SGRH.FecharFolha:output_type -> proto.FolhaPagamentoResponse
+	20, // 34: proto.SGRH.ListarFolhas:output_type -> proto.ListarFolhasResponse
+	19, // 35: proto.SGRH.ObterFolha:output_type -> proto.FolhaPagamentoResponse
+	24, // 36: proto.SGRH.ImportarColaboradores:output_type -> proto.ImportarColaboradoresResponse
+	26, // [26:37] is the sub-list for method output_type
+	15, // [15:26] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_sgrh_proto_init() }
@@ -795,7 +2093,7 @@ func file_proto_sgrh_proto_init() {
 			}
 		}
 		file_proto_sgrh_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListarColaboradoresRequest); i {
+			switch v := v.(*AtualizarColaboradorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -807,7 +2105,7 @@ func file_proto_sgrh_proto_init() {
 			}
 		}
 		file_proto_sgrh_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListarColaboradoresResponse); i {
+			switch v := v.(*TransferirColaboradorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -819,7 +2117,7 @@ func file_proto_sgrh_proto_init() {
 			}
 		}
 		file_proto_sgrh_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalcularFolhaSalarialRequest); i {
+			switch v := v.(*ListarColaboradoresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -831,7 +2129,7 @@ func file_proto_sgrh_proto_init() {
 			}
 		}
 		file_proto_sgrh_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CalcularFolhaSalarialResponse); i {
+			switch v := v.(*ListarColaboradoresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -843,6 +2141,198 @@ func file_proto_sgrh_proto_init() {
 			}
 		}
 		file_proto_sgrh_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamColaboradoresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginaColaboradores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcularFolhaSalarialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcularFolhaSalarialResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FecharFolhaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListarFolhasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObterFolhaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemFolha); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalPorTipo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolhaPagamento); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolhaPagamentoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListarFolhasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpcoesImportacao); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportarColaboradoresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErroImportacao); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportarColaboradoresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_sgrh_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SGRHResponse); i {
 			case 0:
 				return &v.state
@@ -860,13 +2350,18 @@ func file_proto_sgrh_proto_init() {
 		(*Colaborador_Autonomo)(nil),
 		(*Colaborador_AuxilioEstagio)(nil),
 	}
+	file_proto_sgrh_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_proto_sgrh_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*ImportarColaboradoresRequest_Opcoes)(nil),
+		(*ImportarColaboradoresRequest_Colaborador)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_sgrh_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
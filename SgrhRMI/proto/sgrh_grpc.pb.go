@@ -20,8 +20,19 @@ const _ = grpc.SupportPackageIsVersion7
 type SGRHClient interface {
 	AdicionarColaborador(ctx context.Context, in *AddColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error)
 	DemitirColaborador(ctx context.Context, in *DemitirColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error)
+	// Atualização parcial: só os campos presentes na requisição são alterados
+	AtualizarColaborador(ctx context.Context, in *AtualizarColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error)
+	// Move o colaborador entre departamentos de forma atômica; falha se o ID já existir no destino
+	TransferirColaborador(ctx context.Context, in *TransferirColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error)
 	ListarColaboradores(ctx context.Context, in *ListarColaboradoresRequest, opts ...grpc.CallOption) (*ListarColaboradoresResponse, error)
+	StreamColaboradores(ctx context.Context, in *StreamColaboradoresRequest, opts ...grpc.CallOption) (SGRH_StreamColaboradoresClient, error)
 	CalcularFolhaSalarial(ctx context.Context, in *CalcularFolhaSalarialRequest, opts ...grpc.CallOption) (*CalcularFolhaSalarialResponse, error)
+	// Congela a folha do departamento para uma competência; cada competência só fecha uma vez
+	FecharFolha(ctx context.Context, in *FecharFolhaRequest, opts ...grpc.CallOption) (*FolhaPagamentoResponse, error)
+	ListarFolhas(ctx context.Context, in *ListarFolhasRequest, opts ...grpc.CallOption) (*ListarFolhasResponse, error)
+	ObterFolha(ctx context.Context, in *ObterFolhaRequest, opts ...grpc.CallOption) (*FolhaPagamentoResponse, error)
+	// A primeira mensagem pode trazer as opções do lote; as demais trazem os colaboradores
+	ImportarColaboradores(ctx context.Context, opts ...grpc.CallOption) (SGRH_ImportarColaboradoresClient, error)
 }
 
 type sGRHClient struct {
@@ -50,6 +61,24 @@ func (c *sGRHClient) DemitirColaborador(ctx context.Context, in *DemitirColabora
 	return out, nil
 }
 
+func (c *sGRHClient) AtualizarColaborador(ctx context.Context, in *AtualizarColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error) {
+	out := new(SGRHResponse)
+	err := c.cc.Invoke(ctx, "/proto.SGRH/AtualizarColaborador", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sGRHClient) TransferirColaborador(ctx context.Context, in *TransferirColaboradorRequest, opts ...grpc.CallOption) (*SGRHResponse, error) {
+	out := new(SGRHResponse)
+	err := c.cc.Invoke(ctx, "/proto.SGRH/TransferirColaborador", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sGRHClient) ListarColaboradores(ctx context.Context, in *ListarColaboradoresRequest, opts ...grpc.CallOption) (*ListarColaboradoresResponse, error) {
 	out := new(ListarColaboradoresResponse)
 	err := c.cc.Invoke(ctx, "/proto.SGRH/ListarColaboradores", in, out, opts...)
@@ -59,6 +88,38 @@ func (c *sGRHClient) ListarColaboradores(ctx context.Context, in *ListarColabora
 	return out, nil
 }
 
+func (c *sGRHClient) StreamColaboradores(ctx context.Context, in *StreamColaboradoresRequest, opts ...grpc.CallOption) (SGRH_StreamColaboradoresClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SGRH_ServiceDesc.Streams[0], "/proto.SGRH/StreamColaboradores", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sGRHStreamColaboradoresClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SGRH_StreamColaboradoresClient interface {
+	Recv() (*PaginaColaboradores, error)
+	grpc.ClientStream
+}
+
+type sGRHStreamColaboradoresClient struct {
+	grpc.ClientStream
+}
+
+func (x *sGRHStreamColaboradoresClient) Recv() (*PaginaColaboradores, error) {
+	m := new(PaginaColaboradores)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sGRHClient) CalcularFolhaSalarial(ctx context.Context, in *CalcularFolhaSalarialRequest, opts ...grpc.CallOption) (*CalcularFolhaSalarialResponse, error) {
 	out := new(CalcularFolhaSalarialResponse)
 	err := c.cc.Invoke(ctx, "/proto.SGRH/CalcularFolhaSalarial", in, out, opts...)
@@ -68,14 +129,86 @@ func (c *sGRHClient) CalcularFolhaSalarial(ctx context.Context, in *CalcularFolh
 	return out, nil
 }
 
+func (c *sGRHClient) FecharFolha(ctx context.Context, in *FecharFolhaRequest, opts ...grpc.CallOption) (*FolhaPagamentoResponse, error) {
+	out := new(FolhaPagamentoResponse)
+	err := c.cc.Invoke(ctx, "/proto.SGRH/FecharFolha", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sGRHClient) ListarFolhas(ctx context.Context, in *ListarFolhasRequest, opts ...grpc.CallOption) (*ListarFolhasResponse, error) {
+	out := new(ListarFolhasResponse)
+	err := c.cc.Invoke(ctx, "/proto.SGRH/ListarFolhas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sGRHClient) ObterFolha(ctx context.Context, in *ObterFolhaRequest, opts ...grpc.CallOption) (*FolhaPagamentoResponse, error) {
+	out := new(FolhaPagamentoResponse)
+	err := c.cc.Invoke(ctx, "/proto.SGRH/ObterFolha", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sGRHClient) ImportarColaboradores(ctx context.Context, opts ...grpc.CallOption) (SGRH_ImportarColaboradoresClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SGRH_ServiceDesc.Streams[1], "/proto.SGRH/ImportarColaboradores", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sGRHImportarColaboradoresClient{stream}
+	return x, nil
+}
+
+type SGRH_ImportarColaboradoresClient interface {
+	Send(*ImportarColaboradoresRequest) error
+	CloseAndRecv() (*ImportarColaboradoresResponse, error)
+	grpc.ClientStream
+}
+
+type sGRHImportarColaboradoresClient struct {
+	grpc.ClientStream
+}
+
+func (x *sGRHImportarColaboradoresClient) Send(m *ImportarColaboradoresRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sGRHImportarColaboradoresClient) CloseAndRecv() (*ImportarColaboradoresResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportarColaboradoresResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SGRHServer is the server API for SGRH service.
 // All implementations must embed UnimplementedSGRHServer
 // for forward compatibility
 type SGRHServer interface {
 	AdicionarColaborador(context.Context, *AddColaboradorRequest) (*SGRHResponse, error)
 	DemitirColaborador(context.Context, *DemitirColaboradorRequest) (*SGRHResponse, error)
+	// Atualização parcial: só os campos presentes na requisição são alterados
+	AtualizarColaborador(context.Context, *AtualizarColaboradorRequest) (*SGRHResponse, error)
+	// Move o colaborador entre departamentos de forma atômica; falha se o ID já existir no destino
+	TransferirColaborador(context.Context, *TransferirColaboradorRequest) (*SGRHResponse, error)
 	ListarColaboradores(context.Context, *ListarColaboradoresRequest) (*ListarColaboradoresResponse, error)
+	StreamColaboradores(*StreamColaboradoresRequest, SGRH_StreamColaboradoresServer) error
 	CalcularFolhaSalarial(context.Context, *CalcularFolhaSalarialRequest) (*CalcularFolhaSalarialResponse, error)
+	// Congela a folha do departamento para uma competência; cada competência só fecha uma vez
+	FecharFolha(context.Context, *FecharFolhaRequest) (*FolhaPagamentoResponse, error)
+	ListarFolhas(context.Context, *ListarFolhasRequest) (*ListarFolhasResponse, error)
+	ObterFolha(context.Context, *ObterFolhaRequest) (*FolhaPagamentoResponse, error)
+	// A primeira mensagem pode trazer as opções do lote; as demais trazem os colaboradores
+	ImportarColaboradores(SGRH_ImportarColaboradoresServer) error
 	mustEmbedUnimplementedSGRHServer()
 }
 
@@ -89,12 +222,33 @@ func (UnimplementedSGRHServer) AdicionarColaborador(context.Context, *AddColabor
 func (UnimplementedSGRHServer) DemitirColaborador(context.Context, *DemitirColaboradorRequest) (*SGRHResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DemitirColaborador not implemented")
 }
+func (UnimplementedSGRHServer) AtualizarColaborador(context.Context, *AtualizarColaboradorRequest) (*SGRHResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AtualizarColaborador not implemented")
+}
+func (UnimplementedSGRHServer) TransferirColaborador(context.Context, *TransferirColaboradorRequest) (*SGRHResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferirColaborador not implemented")
+}
 func (UnimplementedSGRHServer) ListarColaboradores(context.Context, *ListarColaboradoresRequest) (*ListarColaboradoresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListarColaboradores not implemented")
 }
+func (UnimplementedSGRHServer) StreamColaboradores(*StreamColaboradoresRequest, SGRH_StreamColaboradoresServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamColaboradores not implemented")
+}
 func (UnimplementedSGRHServer) CalcularFolhaSalarial(context.Context, *CalcularFolhaSalarialRequest) (*CalcularFolhaSalarialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcularFolhaSalarial not implemented")
 }
+func (UnimplementedSGRHServer) FecharFolha(context.Context, *FecharFolhaRequest) (*FolhaPagamentoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FecharFolha not implemented")
+}
+func (UnimplementedSGRHServer) ListarFolhas(context.Context, *ListarFolhasRequest) (*ListarFolhasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListarFolhas not implemented")
+}
+func (UnimplementedSGRHServer) ObterFolha(context.Context, *ObterFolhaRequest) (*FolhaPagamentoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObterFolha not implemented")
+}
+func (UnimplementedSGRHServer) ImportarColaboradores(SGRH_ImportarColaboradoresServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportarColaboradores not implemented")
+}
 func (UnimplementedSGRHServer) mustEmbedUnimplementedSGRHServer() {}
 
 // UnsafeSGRHServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +298,42 @@ func _SGRH_DemitirColaborador_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SGRH_AtualizarColaborador_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AtualizarColaboradorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SGRHServer).AtualizarColaborador(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SGRH/AtualizarColaborador",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SGRHServer).AtualizarColaborador(ctx, req.(*AtualizarColaboradorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SGRH_TransferirColaborador_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferirColaboradorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SGRHServer).TransferirColaborador(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SGRH/TransferirColaborador",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SGRHServer).TransferirColaborador(ctx, req.(*TransferirColaboradorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SGRH_ListarColaboradores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListarColaboradoresRequest)
 	if err := dec(in); err != nil {
@@ -162,6 +352,27 @@ func _SGRH_ListarColaboradores_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SGRH_StreamColaboradores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamColaboradoresRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SGRHServer).StreamColaboradores(m, &sGRHStreamColaboradoresServer{stream})
+}
+
+type SGRH_StreamColaboradoresServer interface {
+	Send(*PaginaColaboradores) error
+	grpc.ServerStream
+}
+
+type sGRHStreamColaboradoresServer struct {
+	grpc.ServerStream
+}
+
+func (x *sGRHStreamColaboradoresServer) Send(m *PaginaColaboradores) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _SGRH_CalcularFolhaSalarial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalcularFolhaSalarialRequest)
 	if err := dec(in); err != nil {
@@ -180,6 +391,86 @@ func _SGRH_CalcularFolhaSalarial_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SGRH_FecharFolha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FecharFolhaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SGRHServer).FecharFolha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SGRH/FecharFolha",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SGRHServer).FecharFolha(ctx, req.(*FecharFolhaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SGRH_ListarFolhas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListarFolhasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SGRHServer).ListarFolhas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SGRH/ListarFolhas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SGRHServer).ListarFolhas(ctx, req.(*ListarFolhasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SGRH_ObterFolha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObterFolhaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SGRHServer).ObterFolha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SGRH/ObterFolha",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SGRHServer).ObterFolha(ctx, req.(*ObterFolhaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SGRH_ImportarColaboradores_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SGRHServer).ImportarColaboradores(&sGRHImportarColaboradoresServer{stream})
+}
+
+type SGRH_ImportarColaboradoresServer interface {
+	SendAndClose(*ImportarColaboradoresResponse) error
+	Recv() (*ImportarColaboradoresRequest, error)
+	grpc.ServerStream
+}
+
+type sGRHImportarColaboradoresServer struct {
+	grpc.ServerStream
+}
+
+func (x *sGRHImportarColaboradoresServer) SendAndClose(m *ImportarColaboradoresResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sGRHImportarColaboradoresServer) Recv() (*ImportarColaboradoresRequest, error) {
+	m := new(ImportarColaboradoresRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SGRH_ServiceDesc is the grpc.ServiceDesc for SGRH service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +486,14 @@ var SGRH_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DemitirColaborador",
 			Handler:    _SGRH_DemitirColaborador_Handler,
 		},
+		{
+			MethodName: "AtualizarColaborador",
+			Handler:    _SGRH_AtualizarColaborador_Handler,
+		},
+		{
+			MethodName: "TransferirColaborador",
+			Handler:    _SGRH_TransferirColaborador_Handler,
+		},
 		{
 			MethodName: "ListarColaboradores",
 			Handler:    _SGRH_ListarColaboradores_Handler,
@@ -203,7 +502,30 @@ var SGRH_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalcularFolhaSalarial",
 			Handler:    _SGRH_CalcularFolhaSalarial_Handler,
 		},
+		{
+			MethodName: "FecharFolha",
+			Handler:    _SGRH_FecharFolha_Handler,
+		},
+		{
+			MethodName: "ListarFolhas",
+			Handler:    _SGRH_ListarFolhas_Handler,
+		},
+		{
+			MethodName: "ObterFolha",
+			Handler:    _SGRH_ObterFolha_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamColaboradores",
+			Handler:       _SGRH_StreamColaboradores_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportarColaboradores",
+			Handler:       _SGRH_ImportarColaboradores_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/sgrh.proto",
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math"
+
+	pb "rmi/proto"
+)
+
+// Tabelas de 2025 (INSS a partir de janeiro, IRRF a partir de maio). A mensagem
+// Colaborador não tem dependentes, então o IRRF é calculado sem essa dedução.
+var (
+	faixasINSS = []struct{ ate, aliquota float64 }{
+		{1518.00, 0.075},
+		{2793.88, 0.09},
+		{4190.83, 0.12},
+		{8157.41, 0.14}, // Teto
+	}
+	faixasIRRF = []struct{ ate, aliquota, parcelaDeduzir float64 }{
+		{2428.80, 0, 0},
+		{2826.65, 0.075, 182.16},
+		{3751.05, 0.15, 394.16},
+		{4664.68, 0.225, 675.49},
+		{0, 0.275, 908.73}, // Última faixa
+	}
+)
+
+const (
+	aliquotaFGTS         = 0.08
+	aliquotaINSSAutonomo = 0.11
+)
+
+// CalcularDescontos devolve o que é retido do colaborador (INSS e IRRF) e os encargos
+// do empregador (FGTS). Efetivos pagam INSS progressivo e geram FGTS; autônomos pagam
+// INSS de contribuinte individual até o teto; estagiários só têm IRRF.
+func (c *Colaborador) CalcularDescontos() (descontos, encargos float64) {
+	bruto := arredondar(c.CalcularSalario())
+	inss := 0.0
+	switch c.Tipo {
+	case pb.TipoColaborador_EFETIVO:
+		anterior := 0.0
+		for _, f := range faixasINSS {
+			if bruto <= anterior {
+				break
+			}
+			inss += (math.Min(bruto, f.ate) - anterior) * f.aliquota
+			anterior = f.ate
+		}
+		encargos = arredondar(bruto * aliquotaFGTS)
+	case pb.TipoColaborador_AUTONOMO:
+		inss = math.Min(bruto, faixasINSS[len(faixasINSS)-1].ate) * aliquotaINSSAutonomo
+	}
+	inss = arredondar(inss)
+	return arredondar(inss + irrf(math.Max(0, arredondar(bruto-inss)))), encargos
+}
+
+func irrf(base float64) float64 {
+	for _, f := range faixasIRRF {
+		if f.ate == 0 || base <= f.ate {
+			return arredondar(math.Max(0, base*f.aliquota-f.parcelaDeduzir))
+		}
+	}
+	return 0
+}
+
+func arredondar(v float64) float64 {
+	return math.Round(v*100) / 100
+}
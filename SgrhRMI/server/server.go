@@ -2,18 +2,28 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
 	"net"
+	"sort"
 	"sync"
+	"time"
 
 	pb "rmi/proto" 
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 
+const (
+	tamanhoPaginaPadrao = 50
+	formatoCompetencia  = "2006-01"
+)
+
 type Colaborador struct {
-	pb.Colaborador
+	*pb.Colaborador
 }
 
 func (c *Colaborador) CalcularSalario() float64 {
@@ -38,13 +48,30 @@ type sgrhServer struct {
 	pb.UnimplementedSGRHServer
 	mu            sync.Mutex
 	departamentos map[string]*Departamento
+	folhas        map[string]map[string]*pb.FolhaPagamento // departamento -> competência -> folha fechada
 }
 
 
+// donoDoID procura o ID em todos os departamentos, já que ele identifica um único
+// colaborador em toda a empresa. Deve ser chamado com s.mu travado.
+func (s *sgrhServer) donoDoID(id int32) (string, bool) {
+	for nome, dep := range s.departamentos {
+		if _, exists := dep.Colaboradores[id]; exists {
+			return nome, true
+		}
+	}
+	return "", false
+}
+
 func (s *sgrhServer) AdicionarColaborador(ctx context.Context, req *pb.AddColaboradorRequest) (*pb.SGRHResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	colabID := req.Colaborador.Id
+	if dono, emUso := s.donoDoID(colabID); emUso {
+		return &pb.SGRHResponse{Success: false, Message: fmt.Sprintf("ID %d já pertence a um colaborador do departamento '%s'.", colabID, dono)}, nil
+	}
+
 	dep, exists := s.departamentos[req.NomeDepartamento]
 	if !exists {
 		dep = &Departamento{
@@ -54,12 +81,7 @@ func (s *sgrhServer) AdicionarColaborador(ctx context.Context, req *pb.AddColabo
 		s.departamentos[req.NomeDepartamento] = dep
 	}
 
-	colabID := req.Colaborador.Id
-	if _, exists := dep.Colaboradores[colabID]; exists {
-		return &pb.SGRHResponse{Success: false, Message: "Colaborador com este ID já existe no departamento."}, nil
-	}
-
-	dep.Colaboradores[colabID] = &Colaborador{*req.Colaborador}
+	dep.Colaboradores[colabID] = &Colaborador{proto.Clone(req.Colaborador).(*pb.Colaborador)}
 	log.Printf("Colaborador %s (ID: %d) adicionado ao depto %s", req.Colaborador.Nome, colabID, req.NomeDepartamento)
 	return &pb.SGRHResponse{Success: true, Message: "Colaborador adicionado com sucesso."}, nil
 }
@@ -84,6 +106,100 @@ func (s *sgrhServer) DemitirColaborador(ctx context.Context, req *pb.DemitirCola
 }
 
 
+func (s *sgrhServer) AtualizarColaborador(ctx context.Context, req *pb.AtualizarColaboradorRequest) (*pb.SGRHResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dep, exists := s.departamentos[req.NomeDepartamento]
+	if !exists {
+		return &pb.SGRHResponse{Success: false, Message: "Departamento não encontrado."}, nil
+	}
+
+	atual, exists := dep.Colaboradores[req.ColaboradorId]
+	if !exists {
+		return &pb.SGRHResponse{Success: false, Message: "Colaborador não encontrado no departamento."}, nil
+	}
+
+	// A mensagem armazenada pode estar sendo serializada por uma listagem; alteramos uma cópia.
+	novo := proto.Clone(atual.Colaborador).(*pb.Colaborador)
+	if req.Nome != nil {
+		novo.Nome = req.GetNome()
+	}
+	switch novo.Tipo {
+	case pb.TipoColaborador_EFETIVO:
+		if req.ValorHora != nil || req.HorasTrabalhadas != nil || req.AuxilioEstagio != nil {
+			return &pb.SGRHResponse{Success: false, Message: "Colaborador efetivo só aceita nome e salário mensal."}, nil
+		}
+		if req.SalarioMensal != nil {
+			novo.DetalhesSalario = &pb.Colaborador_SalarioMensal{SalarioMensal: req.GetSalarioMensal()}
+		}
+	case pb.TipoColaborador_AUTONOMO:
+		if req.SalarioMensal != nil || req.AuxilioEstagio != nil {
+			return &pb.SGRHResponse{Success: false, Message: "Colaborador autônomo só aceita nome, valor hora e horas trabalhadas."}, nil
+		}
+		detalhes := &pb.DetalhesAutonomo{}
+		if novo.GetAutonomo() != nil {
+			detalhes = novo.GetAutonomo()
+		}
+		if req.ValorHora != nil {
+			detalhes.ValorHora = req.GetValorHora()
+		}
+		if req.HorasTrabalhadas != nil {
+			detalhes.HorasTrabalhadas = req.GetHorasTrabalhadas()
+		}
+		novo.DetalhesSalario = &pb.Colaborador_Autonomo{Autonomo: detalhes}
+	case pb.TipoColaborador_ESTAGIARIO:
+		if req.SalarioMensal != nil || req.ValorHora != nil || req.HorasTrabalhadas != nil {
+			return &pb.SGRHResponse{Success: false, Message: "Colaborador estagiário só aceita nome e auxílio estágio."}, nil
+		}
+		if req.AuxilioEstagio != nil {
+			novo.DetalhesSalario = &pb.Colaborador_AuxilioEstagio{AuxilioEstagio: req.GetAuxilioEstagio()}
+		}
+	}
+
+	dep.Colaboradores[req.ColaboradorId] = &Colaborador{novo}
+	log.Printf("Colaborador ID: %d atualizado no depto %s", req.ColaboradorId, req.NomeDepartamento)
+	return &pb.SGRHResponse{Success: true, Message: "Colaborador atualizado com sucesso."}, nil
+}
+
+
+func (s *sgrhServer) TransferirColaborador(ctx context.Context, req *pb.TransferirColaboradorRequest) (*pb.SGRHResponse, error) {
+	// Um único lock cobre origem e destino: o colaborador nunca fica fora dos dois.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.DepartamentoOrigem == req.DepartamentoDestino {
+		return &pb.SGRHResponse{Success: false, Message: "Departamentos de origem e destino são iguais."}, nil
+	}
+
+	origem, exists := s.departamentos[req.DepartamentoOrigem]
+	if !exists {
+		return &pb.SGRHResponse{Success: false, Message: "Departamento de origem não encontrado."}, nil
+	}
+	colab, exists := origem.Colaboradores[req.ColaboradorId]
+	if !exists {
+		return &pb.SGRHResponse{Success: false, Message: "Colaborador não encontrado no departamento de origem."}, nil
+	}
+
+	destino, exists := s.departamentos[req.DepartamentoDestino]
+	if !exists {
+		destino = &Departamento{
+			Nome:          req.DepartamentoDestino,
+			Colaboradores: make(map[int32]*Colaborador),
+		}
+		s.departamentos[req.DepartamentoDestino] = destino
+	}
+	if _, exists := destino.Colaboradores[req.ColaboradorId]; exists {
+		return &pb.SGRHResponse{Success: false, Message: "Colaborador com este ID já existe no departamento de destino."}, nil
+	}
+
+	delete(origem.Colaboradores, req.ColaboradorId)
+	destino.Colaboradores[req.ColaboradorId] = colab
+	log.Printf("Colaborador ID: %d transferido do depto %s para %s", req.ColaboradorId, req.DepartamentoOrigem, req.DepartamentoDestino)
+	return &pb.SGRHResponse{Success: true, Message: "Colaborador transferido com sucesso."}, nil
+}
+
+
 func (s *sgrhServer) ListarColaboradores(ctx context.Context, req *pb.ListarColaboradoresRequest) (*pb.ListarColaboradoresResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -95,13 +211,55 @@ func (s *sgrhServer) ListarColaboradores(ctx context.Context, req *pb.ListarCola
 
 	var colabsProto []*pb.Colaborador
 	for _, c := range dep.Colaboradores {
-		colabsProto = append(colabsProto, &c.Colaborador)
+		colabsProto = append(colabsProto, c.Colaborador)
 	}
 
 	return &pb.ListarColaboradoresResponse{Colaboradores: colabsProto}, nil
 }
 
 
+func (s *sgrhServer) StreamColaboradores(req *pb.StreamColaboradoresRequest, stream pb.SGRH_StreamColaboradoresServer) error {
+	tamanhoPagina := int(req.TamanhoPagina)
+	if tamanhoPagina <= 0 {
+		tamanhoPagina = tamanhoPaginaPadrao
+	}
+	tiposAceitos := make(map[pb.TipoColaborador]bool)
+	for _, t := range req.Tipos {
+		tiposAceitos[t] = true
+	}
+
+	// Copia os colaboradores sob o lock para não segurá-lo enquanto o cliente consome o stream.
+	s.mu.Lock()
+	var colabsProto []*pb.Colaborador
+	if dep, exists := s.departamentos[req.NomeDepartamento]; exists {
+		for _, c := range dep.Colaboradores {
+			if len(tiposAceitos) > 0 && !tiposAceitos[c.Tipo] {
+				continue
+			}
+			colabsProto = append(colabsProto, proto.Clone(c.Colaborador).(*pb.Colaborador))
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(colabsProto, func(i, j int) bool { return colabsProto[i].Id < colabsProto[j].Id })
+
+	numeroPagina := int32(0)
+	for inicio := 0; inicio < len(colabsProto); inicio += tamanhoPagina {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		fim := min(inicio+tamanhoPagina, len(colabsProto))
+		numeroPagina++
+		pagina := &pb.PaginaColaboradores{Colaboradores: colabsProto[inicio:fim], NumeroPagina: numeroPagina}
+		if err := stream.Send(pagina); err != nil {
+			return err
+		}
+	}
+	log.Printf("%d colaborador(es) do depto %s enviados em %d página(s)", len(colabsProto), req.NomeDepartamento, numeroPagina)
+	return nil
+}
+
+
 func (s *sgrhServer) CalcularFolhaSalarial(ctx context.Context, req *pb.CalcularFolhaSalarialRequest) (*pb.CalcularFolhaSalarialResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -111,12 +269,187 @@ func (s *sgrhServer) CalcularFolhaSalarial(ctx context.Context, req *pb.Calcular
 		return &pb.CalcularFolhaSalarialResponse{TotalFolha: 0.0}, nil
 	}
 
-	total := 0.0
+	resp := &pb.CalcularFolhaSalarialResponse{}
+	for _, c := range dep.Colaboradores {
+		bruto := arredondar(c.CalcularSalario())
+		descontos, encargos := c.CalcularDescontos()
+		resp.TotalFolha += bruto
+		resp.TotalDescontos += descontos
+		resp.TotalLiquido += bruto - descontos
+		resp.CustoEmpregador += bruto + encargos
+	}
+	resp.TotalFolha = arredondar(resp.TotalFolha)
+	resp.TotalDescontos = arredondar(resp.TotalDescontos)
+	resp.TotalLiquido = arredondar(resp.TotalLiquido)
+	resp.CustoEmpregador = arredondar(resp.CustoEmpregador)
+	log.Printf("Folha do depto %s calculada: bruto R$ %.2f, líquido R$ %.2f", req.NomeDepartamento, resp.TotalFolha, resp.TotalLiquido)
+	return resp, nil
+}
+
+func (s *sgrhServer) FecharFolha(ctx context.Context, req *pb.FecharFolhaRequest) (*pb.FolhaPagamentoResponse, error) {
+	if _, err := time.Parse(formatoCompetencia, req.Competencia); err != nil {
+		return &pb.FolhaPagamentoResponse{Success: false, Message: "Competência inválida; use o formato AAAA-MM."}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dep, exists := s.departamentos[req.NomeDepartamento]
+	if !exists {
+		return &pb.FolhaPagamentoResponse{Success: false, Message: "Departamento não encontrado."}, nil
+	}
+	if _, fechada := s.folhas[req.NomeDepartamento][req.Competencia]; fechada {
+		return &pb.FolhaPagamentoResponse{Success: false, Message: "A folha desta competência já foi fechada."}, nil
+	}
+
+	folha := &pb.FolhaPagamento{
+		NomeDepartamento: req.NomeDepartamento,
+		Competencia:      req.Competencia,
+		FechadaEm:        time.Now().Format(time.RFC3339),
+	}
+	totais := make(map[pb.TipoColaborador]*pb.TotalPorTipo)
 	for _, c := range dep.Colaboradores {
-		total += c.CalcularSalario()
+		valor := c.CalcularSalario()
+		folha.Itens = append(folha.Itens, &pb.ItemFolha{ColaboradorId: c.Id, Nome: c.Nome, Tipo: c.Tipo, Valor: valor})
+		if totais[c.Tipo] == nil {
+			totais[c.Tipo] = &pb.TotalPorTipo{Tipo: c.Tipo}
+		}
+		totais[c.Tipo].Quantidade++
+		totais[c.Tipo].Total += valor
+		folha.Total += valor
+	}
+	sort.Slice(folha.Itens, func(i, j int) bool { return folha.Itens[i].ColaboradorId < folha.Itens[j].ColaboradorId })
+	for _, t := range totais {
+		folha.TotaisPorTipo = append(folha.TotaisPorTipo, t)
+	}
+	sort.Slice(folha.TotaisPorTipo, func(i, j int) bool { return folha.TotaisPorTipo[i].Tipo < folha.TotaisPorTipo[j].Tipo })
+
+	if s.folhas[req.NomeDepartamento] == nil {
+		s.folhas[req.NomeDepartamento] = make(map[string]*pb.FolhaPagamento)
+	}
+	s.folhas[req.NomeDepartamento][req.Competencia] = folha
+	log.Printf("Folha %s do depto %s fechada: R$ %.2f (%d colaborador(es))", req.Competencia, req.NomeDepartamento, folha.Total, len(folha.Itens))
+	return &pb.FolhaPagamentoResponse{Success: true, Message: "Folha fechada com sucesso.", Folha: folha}, nil
+}
+
+
+func (s *sgrhServer) ListarFolhas(ctx context.Context, req *pb.ListarFolhasRequest) (*pb.ListarFolhasResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Folhas fechadas nunca são alteradas, então podem ser devolvidas sem cópia.
+	var folhas []*pb.FolhaPagamento
+	for _, f := range s.folhas[req.NomeDepartamento] {
+		folhas = append(folhas, f)
 	}
-	log.Printf("Folha do depto %s calculada: R$ %.2f", req.NomeDepartamento, total)
-	return &pb.CalcularFolhaSalarialResponse{TotalFolha: total}, nil
+	sort.Slice(folhas, func(i, j int) bool { return folhas[i].Competencia < folhas[j].Competencia })
+	return &pb.ListarFolhasResponse{Folhas: folhas}, nil
+}
+
+
+func (s *sgrhServer) ObterFolha(ctx context.Context, req *pb.ObterFolhaRequest) (*pb.FolhaPagamentoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	folha, exists := s.folhas[req.NomeDepartamento][req.Competencia]
+	if !exists {
+		return &pb.FolhaPagamentoResponse{Success: false, Message: "Nenhuma folha fechada para esta competência."}, nil
+	}
+	return &pb.FolhaPagamentoResponse{Success: true, Folha: folha}, nil
+}
+
+type linhaImportacao struct {
+	linha int32
+	req   *pb.AddColaboradorRequest
+}
+
+func (s *sgrhServer) ImportarColaboradores(stream pb.SGRH_ImportarColaboradoresServer) error {
+	tudoOuNada := false
+	resp := &pb.ImportarColaboradoresResponse{}
+	porDepartamento := make(map[string][]linhaImportacao)
+	var ordemDepartamentos []string
+	for primeira := true; ; primeira = false {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if opcoes := msg.GetOpcoes(); opcoes != nil {
+			if !primeira {
+				return fmt.Errorf("as opções da importação só podem vir na primeira mensagem do stream")
+			}
+			tudoOuNada = opcoes.TudoOuNada
+			continue
+		}
+		req := msg.GetColaborador()
+		if req == nil {
+			req = &pb.AddColaboradorRequest{}
+		}
+		resp.TotalRecebidos++
+		linha := resp.TotalRecebidos
+		if req.NomeDepartamento == "" || req.Colaborador == nil {
+			resp.Erros = append(resp.Erros, &pb.ErroImportacao{Linha: linha, NomeDepartamento: req.NomeDepartamento, Mensagem: "Departamento e colaborador são obrigatórios."})
+			continue
+		}
+		if _, visto := porDepartamento[req.NomeDepartamento]; !visto {
+			ordemDepartamentos = append(ordemDepartamentos, req.NomeDepartamento)
+		}
+		porDepartamento[req.NomeDepartamento] = append(porDepartamento[req.NomeDepartamento], linhaImportacao{linha: linha, req: req})
+	}
+
+	// Todo o lote é validado e aplicado sob o mesmo lock, sem intercalar com outras chamadas.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, nomeDepto := range ordemDepartamentos {
+		linhas := porDepartamento[nomeDepto]
+		dep := s.departamentos[nomeDepto]
+
+		idsNoLote := make(map[int32]int32)
+		var validas []linhaImportacao
+		var erros []*pb.ErroImportacao
+		for _, l := range linhas {
+			colabID := l.req.Colaborador.Id
+			// Departamentos já aplicados deste mesmo lote também contam.
+			if dono, emUso := s.donoDoID(colabID); emUso {
+				erros = append(erros, &pb.ErroImportacao{Linha: l.linha, NomeDepartamento: nomeDepto, ColaboradorId: colabID, Mensagem: fmt.Sprintf("ID já pertence a um colaborador do departamento '%s'.", dono)})
+				continue
+			}
+			if linhaAnterior, repetido := idsNoLote[colabID]; repetido {
+				erros = append(erros, &pb.ErroImportacao{Linha: l.linha, NomeDepartamento: nomeDepto, ColaboradorId: colabID, Mensagem: fmt.Sprintf("ID duplicado no lote (já enviado na linha %d).", linhaAnterior)})
+				continue
+			}
+			idsNoLote[colabID] = l.linha
+			validas = append(validas, l)
+		}
+		resp.Erros = append(resp.Erros, erros...)
+
+		if tudoOuNada && len(erros) > 0 {
+			resp.DepartamentosRejeitados = append(resp.DepartamentosRejeitados, nomeDepto)
+			log.Printf("Importação para o depto %s rejeitada: %d erro(s)", nomeDepto, len(erros))
+			continue
+		}
+		if len(validas) == 0 {
+			continue
+		}
+
+		if dep == nil {
+			dep = &Departamento{
+				Nome:          nomeDepto,
+				Colaboradores: make(map[int32]*Colaborador),
+			}
+			s.departamentos[nomeDepto] = dep
+		}
+		for _, l := range validas {
+			dep.Colaboradores[l.req.Colaborador.Id] = &Colaborador{proto.Clone(l.req.Colaborador).(*pb.Colaborador)}
+		}
+		resp.TotalImportados += int32(len(validas))
+		log.Printf("%d colaborador(es) importados para o depto %s", len(validas), nomeDepto)
+	}
+
+	return stream.SendAndClose(resp)
 }
 
 func main() {
@@ -128,6 +461,7 @@ func main() {
 	grpcServer := grpc.NewServer()
 	pb.RegisterSGRHServer(grpcServer, &sgrhServer{
 		departamentos: make(map[string]*Departamento),
+		folhas:        make(map[string]map[string]*pb.FolhaPagamento),
 	})
 
 	log.Println("Servidor RMI (gRPC) escutando em localhost:8088")
@@ -3,12 +3,15 @@ package main
 import (
 	"bufio"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -16,36 +19,97 @@ import (
 )
 
 const (
-	ADMIN_SERVER_ADDR    = "localhost:8080"
-	ADMIN_MULTICAST_ADDR = "224.0.0.1:9999"
-	MAX_MSG_SIZE_ADMIN   = 4096
+	ADMIN_SERVER_ADDR      = "localhost:8080"
+	ADMIN_MULTICAST_ADDR   = "224.0.0.1:9999"
+	MAX_MSG_SIZE_ADMIN     = 4096
+	ADMIN_RESPONSE_TIMEOUT = 10 * time.Second
 )
 
 var adminID string
+var currentElectionID string           // Election addressed by the menu actions; empty means the server's default
+var lastElectionState pb.ElectionState // Reported by the server on responses about currentElectionID
+
+// Responses are matched to requests by request ID. readAdminFrames delivers each one to
+// the channel of its pending request; frames nobody is waiting for go to adminUnsolicitedHandlers.
+var (
+	adminPendingMu     sync.Mutex
+	lastAdminRequestID uint64
+	adminPending       = make(map[uint64]chan *pb.GenericResponse)
+	adminConnClosed    bool // Set by readAdminFrames when the server connection is gone
+)
+
+var adminUnsolicitedHandlers = map[pb.GenericResponse_Type]func(*pb.GenericResponse){
+	pb.GenericResponse_ELECTION_RESULTS: showPushedAdminResults,
+}
 
 // Re-use sendRequest and readResponse (can be refactored into a shared client_util package)
-func sendAdminRequest(conn net.Conn, reqType pb.GenericRequest_Type, payload proto.Message) error {
+func sendAdminRequest(conn net.Conn, reqType pb.GenericRequest_Type, payload proto.Message) (uint64, error) {
 	var payloadBytes []byte
 	var err error
 	if payload != nil {
 		payloadBytes, err = proto.Marshal(payload)
 		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %w", err)
+			return 0, fmt.Errorf("failed to marshal payload: %w", err)
 		}
 	}
-	genericReq := &pb.GenericRequest{Type: reqType, Payload: payloadBytes}
+	// Register before writing, so that the response cannot arrive before we wait for it.
+	adminPendingMu.Lock()
+	if adminConnClosed {
+		adminPendingMu.Unlock()
+		return 0, io.EOF
+	}
+	lastAdminRequestID++
+	requestID := lastAdminRequestID
+	adminPending[requestID] = make(chan *pb.GenericResponse, 1)
+	adminPendingMu.Unlock()
+
+	genericReq := &pb.GenericRequest{Type: reqType, Payload: payloadBytes, ElectionId: currentElectionID, RequestId: requestID}
 	data, err := proto.Marshal(genericReq)
 	if err != nil {
-		return fmt.Errorf("failed to marshal generic request: %w", err)
+		forgetAdminRequest(requestID)
+		return 0, fmt.Errorf("failed to marshal generic request: %w", err)
 	}
 	if err := binary.Write(conn, binary.BigEndian, uint32(len(data))); err != nil {
-		return fmt.Errorf("failed to write message length: %w", err)
+		forgetAdminRequest(requestID)
+		return 0, fmt.Errorf("failed to write message length: %w", err)
 	}
 	if _, err := conn.Write(data); err != nil {
-		return fmt.Errorf("failed to write message data: %w", err)
+		forgetAdminRequest(requestID)
+		return 0, fmt.Errorf("failed to write message data: %w", err)
+	}
+	log.Printf("Admin: Sent %s request #%d", reqType, requestID)
+	return requestID, nil
+}
+
+// awaitAdminResponse waits for the response to requestID, returning io.EOF if the
+// connection closes first.
+func awaitAdminResponse(requestID uint64) (*pb.GenericResponse, error) {
+	adminPendingMu.Lock()
+	replyCh, ok := adminPending[requestID]
+	adminPendingMu.Unlock()
+	if !ok {
+		return nil, io.EOF
+	}
+	defer forgetAdminRequest(requestID)
+
+	select {
+	case resp, ok := <-replyCh:
+		if !ok {
+			return nil, io.EOF
+		}
+		if resp.ElectionId != "" && resp.ElectionId == currentElectionID {
+			lastElectionState = resp.ElectionState
+		}
+		return resp, nil
+	case <-time.After(ADMIN_RESPONSE_TIMEOUT):
+		return nil, fmt.Errorf("no response to request #%d after %s", requestID, ADMIN_RESPONSE_TIMEOUT)
 	}
-	log.Printf("Admin: Sent %s request", reqType)
-	return nil
+}
+
+func forgetAdminRequest(requestID uint64) {
+	adminPendingMu.Lock()
+	delete(adminPending, requestID)
+	adminPendingMu.Unlock()
 }
 
 func readAdminResponse(conn net.Conn) (*pb.GenericResponse, error) {
@@ -65,10 +129,64 @@ func readAdminResponse(conn net.Conn) (*pb.GenericResponse, error) {
 	if err := proto.Unmarshal(msgBytes, resp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal admin generic response: %w", err)
 	}
-	log.Printf("Admin: Received response: Type=%s, Success=%t, Message='%s'", resp.Type, resp.Success, resp.Message)
+	log.Printf("Admin: Received response #%d: Type=%s, Success=%t, Election=%s, State=%s, Message='%s'", resp.RequestId, resp.Type, resp.Success, resp.ElectionId, resp.ElectionState, resp.Message)
 	return resp, nil
 }
 
+// readAdminFrames reads every frame the server sends, for as long as the connection lasts.
+func readAdminFrames(conn net.Conn) {
+	for {
+		resp, err := readAdminResponse(conn)
+		if err != nil {
+			if err != io.EOF && !errors.Is(err, net.ErrClosed) { // ErrClosed: we closed the connection on exit
+				log.Printf("Admin: Failed to read from server: %v", err)
+			}
+			adminPendingMu.Lock()
+			adminConnClosed = true
+			for requestID, replyCh := range adminPending { // Wakes every awaitAdminResponse with io.EOF
+				close(replyCh)
+				delete(adminPending, requestID)
+			}
+			adminPendingMu.Unlock()
+			return
+		}
+
+		adminPendingMu.Lock()
+		replyCh := adminPending[resp.RequestId] // Pushed frames carry request ID 0, which is never pending
+		adminPendingMu.Unlock()
+		if replyCh == nil {
+			dispatchAdminUnsolicited(resp)
+			continue
+		}
+		select {
+		case replyCh <- resp:
+		default:
+			log.Printf("Admin: Dropping duplicate response to request #%d", resp.RequestId)
+		}
+	}
+}
+
+// dispatchAdminUnsolicited hands a frame that answers no pending request to the handler
+// for its type: server pushes, and responses that arrived after awaitAdminResponse gave up.
+func dispatchAdminUnsolicited(resp *pb.GenericResponse) {
+	if handler, ok := adminUnsolicitedHandlers[resp.Type]; ok {
+		handler(resp)
+		return
+	}
+	log.Printf("Admin: Ignoring unsolicited %s frame: %s", resp.Type, resp.Message)
+}
+
+func showPushedAdminResults(resp *pb.GenericResponse) {
+	erp := &pb.ElectionResultsPayload{}
+	if err := proto.Unmarshal(resp.Payload, erp); err != nil {
+		log.Printf("Admin: Failed to unmarshal pushed election results: %v", err)
+		return
+	}
+	fmt.Printf("\n📢 %s\n", resp.Message)
+	displayAdminResults(erp)
+	fmt.Print("> ")
+}
+
 func sendMulticastNote(loggedInAdminID string, content string) {
 	mAddr, err := net.ResolveUDPAddr("udp", ADMIN_MULTICAST_ADDR)
 	if err != nil {
@@ -111,6 +229,8 @@ func main() {
 	}
 	defer conn.Close()
 
+	go readAdminFrames(conn)
+
 	reader := bufio.NewReader(os.Stdin)
 
 	fmt.Print("Enter Admin User ID: ")
@@ -125,11 +245,12 @@ func main() {
 		Password: strings.TrimSpace(password),
 		UserType: pb.UserType_ADMIN,
 	}
-	if err := sendAdminRequest(conn, pb.GenericRequest_LOGIN, loginPayload); err != nil {
+	loginID, err := sendAdminRequest(conn, pb.GenericRequest_LOGIN, loginPayload)
+	if err != nil {
 		log.Fatalf("Admin: Failed to send login request: %v", err)
 	}
 
-	resp, err := readAdminResponse(conn)
+	resp, err := awaitAdminResponse(loginID)
 	if err != nil {
 		if err == io.EOF { log.Fatalf("Admin: Connection closed by server during login.")}
 		log.Fatalf("Admin: Failed to read login response: %v", err)
@@ -139,15 +260,28 @@ func main() {
 	}
 	fmt.Println("Admin login successful!")
 	fmt.Println(resp.Message)
+	elp := &pb.ElectionListPayload{}
+	if err := proto.Unmarshal(resp.Payload, elp); err == nil {
+		displayElections(elp)
+		if len(elp.Elections) > 0 { // Start with the first election; option 9 switches
+			currentElectionID = elp.Elections[0].ElectionId
+			lastElectionState = elp.Elections[0].State
+		}
+	}
 
 
 	for {
-		fmt.Println("\nAdmin Menu:")
+		fmt.Printf("\nAdmin Menu (election %s is %s):\n", currentElectionID, lastElectionState)
 		fmt.Println("1. Add Candidate")
 		fmt.Println("2. Remove Candidate")
 		fmt.Println("3. Send Informative Note (Multicast)")
-		// Could add: 4. Start New Election (would reset server state, set new deadline)
-		fmt.Println("4. Exit")
+		fmt.Println("4. Start Election")
+		fmt.Println("5. Extend Voting Deadline")
+		fmt.Println("6. Close Voting Now")
+		fmt.Println("7. Publish Results")
+		fmt.Println("8. Create Election")
+		fmt.Println("9. Select Election")
+		fmt.Println("10. Exit")
 		fmt.Print("> ")
 
 		choiceInput, _ := reader.ReadString('\n')
@@ -166,11 +300,12 @@ func main() {
 					Name: strings.TrimSpace(candName),
 				},
 			}
-			if err := sendAdminRequest(conn, pb.GenericRequest_ADD_CANDIDATE, addPayload); err != nil {
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_ADD_CANDIDATE, addPayload)
+			if err != nil {
 				log.Printf("Admin: Failed to send add candidate request: %v", err)
 				continue
 			}
-			resp, err := readAdminResponse(conn)
+			resp, err := awaitAdminResponse(requestID)
 			if err != nil {
 				if err == io.EOF { log.Println("Server closed connection."); return }
 				log.Printf("Admin: Failed to read add candidate response: %v", err)
@@ -184,11 +319,12 @@ func main() {
 			removePayload := &pb.RemoveCandidatePayload{
 				CandidateId: strings.TrimSpace(candIDToRemove),
 			}
-			if err := sendAdminRequest(conn, pb.GenericRequest_REMOVE_CANDIDATE, removePayload); err != nil {
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_REMOVE_CANDIDATE, removePayload)
+			if err != nil {
 				log.Printf("Admin: Failed to send remove candidate request: %v", err)
 				continue
 			}
-			resp, err := readAdminResponse(conn)
+			resp, err := awaitAdminResponse(requestID)
 			if err != nil {
 				if err == io.EOF { log.Println("Server closed connection."); return }
 				log.Printf("Admin: Failed to read remove candidate response: %v", err)
@@ -202,10 +338,198 @@ func main() {
 			sendMulticastNote(adminID, strings.TrimSpace(noteContent))
 
 		case "4":
+			fmt.Print("Enter voting duration in minutes (blank for server default): ")
+			durationInput, _ := reader.ReadString('\n')
+			startPayload := &pb.StartElectionPayload{}
+			if strings.TrimSpace(durationInput) != "" {
+				deadline, err := deadlineFromMinutes(durationInput)
+				if err != nil {
+					fmt.Println(err)
+					continue
+				}
+				startPayload.VotingDeadline = deadline
+			}
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_START_ELECTION, startPayload)
+			if err != nil {
+				log.Printf("Admin: Failed to send start election request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read start election response: %v", err)
+				continue
+			}
+			fmt.Println(resp.Message)
+
+		case "5":
+			fmt.Print("Enter new deadline as minutes from now: ")
+			durationInput, _ := reader.ReadString('\n')
+			deadline, err := deadlineFromMinutes(durationInput)
+			if err != nil {
+				fmt.Println(err)
+				continue
+			}
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_EXTEND_DEADLINE, &pb.ExtendDeadlinePayload{NewDeadline: deadline})
+			if err != nil {
+				log.Printf("Admin: Failed to send extend deadline request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read extend deadline response: %v", err)
+				continue
+			}
+			fmt.Println(resp.Message)
+
+		case "6":
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_CLOSE_NOW, nil)
+			if err != nil {
+				log.Printf("Admin: Failed to send close voting request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read close voting response: %v", err)
+				continue
+			}
+			fmt.Println(resp.Message)
+			if resp.Type == pb.GenericResponse_ELECTION_RESULTS {
+				erp := &pb.ElectionResultsPayload{}
+				if err := proto.Unmarshal(resp.Payload, erp); err == nil {
+					displayAdminResults(erp)
+				}
+			}
+
+		case "7":
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_PUBLISH_RESULTS, nil)
+			if err != nil {
+				log.Printf("Admin: Failed to send publish results request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read publish results response: %v", err)
+				continue
+			}
+			fmt.Println(resp.Message)
+			if resp.Type == pb.GenericResponse_ELECTION_RESULTS {
+				erp := &pb.ElectionResultsPayload{}
+				if err := proto.Unmarshal(resp.Payload, erp); err == nil {
+					displayAdminResults(erp)
+				}
+			}
+
+		case "8":
+			fmt.Print("Enter new election ID: ")
+			newElectionID, _ := reader.ReadString('\n')
+			fmt.Print("Enter election title: ")
+			title, _ := reader.ReadString('\n')
+			fmt.Print("Enter eligible elector IDs, comma-separated (blank for every elector): ")
+			electorsInput, _ := reader.ReadString('\n')
+			createPayload := &pb.CreateElectionPayload{
+				ElectionId: strings.TrimSpace(newElectionID),
+				Title:      strings.TrimSpace(title),
+			}
+			for _, electorID := range strings.Split(electorsInput, ",") {
+				if electorID = strings.TrimSpace(electorID); electorID != "" {
+					createPayload.EligibleElectorIds = append(createPayload.EligibleElectorIds, electorID)
+				}
+			}
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_CREATE_ELECTION, createPayload)
+			if err != nil {
+				log.Printf("Admin: Failed to send create election request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read create election response: %v", err)
+				continue
+			}
+			fmt.Println(resp.Message)
+
+		case "9":
+			requestID, err := sendAdminRequest(conn, pb.GenericRequest_LIST_ELECTIONS, nil)
+			if err != nil {
+				log.Printf("Admin: Failed to send list elections request: %v", err)
+				continue
+			}
+			resp, err := awaitAdminResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Admin: Failed to read list elections response: %v", err)
+				continue
+			}
+			elp := &pb.ElectionListPayload{}
+			if err := proto.Unmarshal(resp.Payload, elp); err != nil {
+				log.Printf("Admin: Failed to unmarshal election list: %v", err)
+				continue
+			}
+			displayElections(elp)
+			fmt.Printf("Enter election ID (blank to keep %s): ", currentElectionID)
+			choiceInput, _ := reader.ReadString('\n')
+			selectElection(elp, strings.TrimSpace(choiceInput))
+
+		case "10":
 			fmt.Println("Admin exiting.")
 			return
 		default:
 			fmt.Println("Invalid option.")
 		}
 	}
-}
\ No newline at end of file
+}
+
+// deadlineFromMinutes turns a number of minutes typed by the admin into an RFC 3339 deadline.
+func deadlineFromMinutes(input string) (string, error) {
+	minutes, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || minutes <= 0 {
+		return "", fmt.Errorf("Invalid number of minutes.")
+	}
+	return time.Now().Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339), nil
+}
+
+func displayAdminResults(erp *pb.ElectionResultsPayload) {
+	fmt.Printf("\n--- Election Results (%s) ---\n", erp.ElectionId)
+	fmt.Printf("%s\n", erp.StatusMessage)
+	fmt.Printf("Total Votes: %d\n", erp.TotalVotes)
+	for _, c := range erp.CandidateResults {
+		fmt.Printf("- %s (%s): %d votes (%.2f%%)\n", c.Name, c.Id, c.VoteCount, c.Percentage)
+	}
+	if erp.Winner != nil && erp.Winner.Name != "" {
+		fmt.Printf("Winner: %s\n", erp.Winner.Name)
+	}
+}
+
+func displayElections(elp *pb.ElectionListPayload) {
+	fmt.Println("\n--- Elections ---")
+	if len(elp.Elections) == 0 {
+		fmt.Println("No elections yet.")
+	}
+	for _, e := range elp.Elections {
+		fmt.Printf("- %s (%s): %s", e.ElectionId, e.Title, e.State)
+		if e.VotingDeadline != "" {
+			fmt.Printf(", deadline %s", e.VotingDeadline)
+		}
+		fmt.Println()
+	}
+}
+
+// selectElection makes electionID the target of the menu actions if it is in the list.
+func selectElection(elp *pb.ElectionListPayload, electionID string) {
+	if electionID == "" {
+		return
+	}
+	for _, e := range elp.Elections {
+		if e.ElectionId == electionID {
+			currentElectionID = e.ElectionId
+			lastElectionState = e.State
+			fmt.Printf("Now managing election %s.\n", currentElectionID)
+			return
+		}
+	}
+	fmt.Println("Unknown election ID.")
+}
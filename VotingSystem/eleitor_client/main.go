@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -20,40 +22,99 @@ const (
 	SERVER_ADDR         = "localhost:8080"
 	MULTICAST_ADDR      = "224.0.0.1:9999"
 	MAX_MSG_SIZE_CLIENT = 4096
+	RESPONSE_TIMEOUT    = 10 * time.Second
 )
 
 var electorID string
-var currentCandidates []*pb.Candidate // Cache candidates for voting
+var currentElectionID string          // Election the menu actions apply to
+var currentCandidates []*pb.Candidate // Cache candidates of currentElectionID for voting
+
+// Responses are matched to requests by request ID. readFrames delivers each one to the
+// channel of its pending request; frames nobody is waiting for go to unsolicitedHandlers.
+var (
+	pendingMu     sync.Mutex
+	lastRequestID uint64
+	pending       = make(map[uint64]chan *pb.GenericResponse)
+	connClosed    bool // Set by readFrames when the server connection is gone
+)
+
+var unsolicitedHandlers = map[pb.GenericResponse_Type]func(*pb.GenericResponse){
+	pb.GenericResponse_ELECTION_RESULTS: showPushedResults,
+}
 
-// Helper to send a framed proto message
-func sendRequest(conn net.Conn, reqType pb.GenericRequest_Type, payload proto.Message) error {
+// Helper to send a framed proto message. It returns the request ID to pass to awaitResponse.
+func sendRequest(conn net.Conn, reqType pb.GenericRequest_Type, payload proto.Message) (uint64, error) {
 	var payloadBytes []byte
 	var err error
 	if payload != nil {
 		payloadBytes, err = proto.Marshal(payload)
 		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %w", err)
+			return 0, fmt.Errorf("failed to marshal payload: %w", err)
 		}
 	}
 
+	// Register before writing, so that the response cannot arrive before we wait for it.
+	pendingMu.Lock()
+	if connClosed {
+		pendingMu.Unlock()
+		return 0, io.EOF
+	}
+	lastRequestID++
+	requestID := lastRequestID
+	pending[requestID] = make(chan *pb.GenericResponse, 1)
+	pendingMu.Unlock()
+
 	genericReq := &pb.GenericRequest{
-		Type:    reqType,
-		Payload: payloadBytes,
+		Type:       reqType,
+		Payload:    payloadBytes,
+		ElectionId: currentElectionID,
+		RequestId:  requestID,
 	}
 
 	data, err := proto.Marshal(genericReq)
 	if err != nil {
-		return fmt.Errorf("failed to marshal generic request: %w", err)
+		forgetRequest(requestID)
+		return 0, fmt.Errorf("failed to marshal generic request: %w", err)
 	}
 
 	if err := binary.Write(conn, binary.BigEndian, uint32(len(data))); err != nil {
-		return fmt.Errorf("failed to write message length: %w", err)
+		forgetRequest(requestID)
+		return 0, fmt.Errorf("failed to write message length: %w", err)
 	}
 	if _, err := conn.Write(data); err != nil {
-		return fmt.Errorf("failed to write message data: %w", err)
+		forgetRequest(requestID)
+		return 0, fmt.Errorf("failed to write message data: %w", err)
 	}
-	log.Printf("Sent %s request", reqType)
-	return nil
+	log.Printf("Sent %s request #%d", reqType, requestID)
+	return requestID, nil
+}
+
+// awaitResponse waits for the response to requestID, returning io.EOF if the connection
+// closes first.
+func awaitResponse(requestID uint64) (*pb.GenericResponse, error) {
+	pendingMu.Lock()
+	replyCh, ok := pending[requestID]
+	pendingMu.Unlock()
+	if !ok {
+		return nil, io.EOF
+	}
+	defer forgetRequest(requestID)
+
+	select {
+	case resp, ok := <-replyCh:
+		if !ok {
+			return nil, io.EOF
+		}
+		return resp, nil
+	case <-time.After(RESPONSE_TIMEOUT):
+		return nil, fmt.Errorf("no response to request #%d after %s", requestID, RESPONSE_TIMEOUT)
+	}
+}
+
+func forgetRequest(requestID uint64) {
+	pendingMu.Lock()
+	delete(pending, requestID)
+	pendingMu.Unlock()
 }
 
 // Helper to read a framed proto response
@@ -88,10 +149,64 @@ func readResponse(conn net.Conn) (*pb.GenericResponse, error) {
 	if err := proto.Unmarshal(msgBytes, resp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal generic response: %w", err)
 	}
-	log.Printf("Received response: Type=%s, Success=%t, Message='%s'", resp.Type, resp.Success, resp.Message)
+	log.Printf("Received response #%d: Type=%s, Success=%t, Election=%s, State=%s, Message='%s'", resp.RequestId, resp.Type, resp.Success, resp.ElectionId, resp.ElectionState, resp.Message)
 	return resp, nil
 }
 
+// readFrames reads every frame the server sends, for as long as the connection lasts.
+func readFrames(conn net.Conn) {
+	for {
+		resp, err := readResponse(conn)
+		if err != nil {
+			if err != io.EOF && !errors.Is(err, net.ErrClosed) { // ErrClosed: we closed the connection on exit
+				log.Printf("Elector: Failed to read from server: %v", err)
+			}
+			pendingMu.Lock()
+			connClosed = true
+			for requestID, replyCh := range pending { // Wakes every awaitResponse with io.EOF
+				close(replyCh)
+				delete(pending, requestID)
+			}
+			pendingMu.Unlock()
+			return
+		}
+
+		pendingMu.Lock()
+		replyCh := pending[resp.RequestId] // Pushed frames carry request ID 0, which is never pending
+		pendingMu.Unlock()
+		if replyCh == nil {
+			dispatchUnsolicited(resp)
+			continue
+		}
+		select {
+		case replyCh <- resp:
+		default:
+			log.Printf("Elector: Dropping duplicate response to request #%d", resp.RequestId)
+		}
+	}
+}
+
+// dispatchUnsolicited hands a frame that answers no pending request to the handler for its
+// type: server pushes, and responses that arrived after awaitResponse gave up.
+func dispatchUnsolicited(resp *pb.GenericResponse) {
+	if handler, ok := unsolicitedHandlers[resp.Type]; ok {
+		handler(resp)
+		return
+	}
+	log.Printf("Elector: Ignoring unsolicited %s frame: %s", resp.Type, resp.Message)
+}
+
+func showPushedResults(resp *pb.GenericResponse) {
+	erp := &pb.ElectionResultsPayload{}
+	if err := proto.Unmarshal(resp.Payload, erp); err != nil {
+		log.Printf("Elector: Failed to unmarshal pushed election results: %v", err)
+		return
+	}
+	fmt.Printf("\n📢 %s\n", resp.Message)
+	displayResults(erp)
+	fmt.Print("> ")
+}
+
 func listenForMulticastNotes() {
 	addr, err := net.ResolveUDPAddr("udp", MULTICAST_ADDR)
 	if err != nil {
@@ -140,6 +255,7 @@ func main() {
 	defer conn.Close()
 
 	go listenForMulticastNotes()
+	go readFrames(conn)
 
 	reader := bufio.NewReader(os.Stdin)
 
@@ -155,11 +271,12 @@ func main() {
 		Password: strings.TrimSpace(password),
 		UserType: pb.UserType_ELECTOR,
 	}
-	if err := sendRequest(conn, pb.GenericRequest_LOGIN, loginPayload); err != nil {
+	loginID, err := sendRequest(conn, pb.GenericRequest_LOGIN, loginPayload)
+	if err != nil {
 		log.Fatalf("Elector: Failed to send login request: %v", err)
 	}
 
-	resp, err := readResponse(conn)
+	resp, err := awaitResponse(loginID)
 	if err != nil {
 		if err == io.EOF {
 			log.Fatalf("Elector: Connection closed by server during login.")
@@ -171,27 +288,25 @@ func main() {
 		log.Fatalf("Elector login failed: %s", resp.Message)
 	}
 	fmt.Println("Elector login successful!")
-	fmt.Println(resp.Message) // Display message from server (e.g. voting open/closed)
-
-	if resp.Type == pb.GenericResponse_LOGIN_SUCCESS_ELECTOR && len(resp.Payload) > 0 {
-		clp := &pb.CandidateListPayload{}
-		if err := proto.Unmarshal(resp.Payload, clp); err == nil {
-			currentCandidates = clp.Candidates
-			fmt.Printf("Voting is open until: %s\n", clp.VotingDeadline)
+	fmt.Println(resp.Message) // Display message from server (e.g. number of elections)
+
+	elp := &pb.ElectionListPayload{}
+	if err := proto.Unmarshal(resp.Payload, elp); err == nil {
+		displayElections(elp)
+		if len(elp.Elections) > 0 { // Start with the first election; option 3 switches
+			selectElection(elp, elp.Elections[0].ElectionId)
+		} else {
+			fmt.Println("You are not eligible to vote in any election.")
 		}
-	} else if resp.Type == pb.GenericResponse_ELECTION_RESULTS && len(resp.Payload) > 0 {
-		erp := &pb.ElectionResultsPayload{}
-         if err := proto.Unmarshal(resp.Payload, erp); err == nil {
-            displayResults(erp)
-        }
 	}
 
 
 	for {
-		fmt.Println("\nElector Menu:")
+		fmt.Printf("\nElector Menu (election %s):\n", currentElectionID)
 		fmt.Println("1. View Candidates / Check Election Status")
 		fmt.Println("2. Vote")
-		fmt.Println("3. Exit")
+		fmt.Println("3. Select Election")
+		fmt.Println("4. Exit")
 		fmt.Print("> ")
 
 		choiceInput, _ := reader.ReadString('\n')
@@ -199,18 +314,19 @@ func main() {
 
 		switch choice {
 		case "1":
-			if err := sendRequest(conn, pb.GenericRequest_GET_CANDIDATES, nil); err != nil {
+			requestID, err := sendRequest(conn, pb.GenericRequest_GET_CANDIDATES, nil)
+			if err != nil {
 				log.Printf("Elector: Failed to send get candidates request: %v", err)
 				continue
 			}
-			resp, err := readResponse(conn)
+			resp, err := awaitResponse(requestID)
 			if err != nil {
 				if err == io.EOF { log.Println("Server closed connection."); return }
 				log.Printf("Elector: Failed to read get candidates response: %v", err)
 				continue
 			}
 			if !resp.Success {
-				fmt.Printf("Error: %s\n", resp.Message)
+				fmt.Printf("Error: %s (election state: %s)\n", resp.Message, resp.ElectionState)
 				continue
 			}
 
@@ -263,11 +379,12 @@ func main() {
 				ElectorId:   electorID,
 				CandidateId: selectedCandidate.Id,
 			}
-			if err := sendRequest(conn, pb.GenericRequest_SUBMIT_VOTE, votePayload); err != nil {
+			requestID, err := sendRequest(conn, pb.GenericRequest_SUBMIT_VOTE, votePayload)
+			if err != nil {
 				log.Printf("Elector: Failed to send vote: %v", err)
 				continue
 			}
-			resp, err := readResponse(conn)
+			resp, err := awaitResponse(requestID)
 			if err != nil {
 				if err == io.EOF { log.Println("Server closed connection."); return }
 				log.Printf("Elector: Failed to read vote response: %v", err)
@@ -276,6 +393,28 @@ func main() {
 			fmt.Println(resp.Message) // Display server's ack/error for the vote
 
 		case "3":
+			requestID, err := sendRequest(conn, pb.GenericRequest_LIST_ELECTIONS, nil)
+			if err != nil {
+				log.Printf("Elector: Failed to send list elections request: %v", err)
+				continue
+			}
+			resp, err := awaitResponse(requestID)
+			if err != nil {
+				if err == io.EOF { log.Println("Server closed connection."); return }
+				log.Printf("Elector: Failed to read list elections response: %v", err)
+				continue
+			}
+			elp := &pb.ElectionListPayload{}
+			if err := proto.Unmarshal(resp.Payload, elp); err != nil {
+				log.Printf("Elector: Failed to unmarshal election list: %v", err)
+				continue
+			}
+			displayElections(elp)
+			fmt.Print("Enter election ID: ")
+			choiceInput, _ := reader.ReadString('\n')
+			selectElection(elp, strings.TrimSpace(choiceInput))
+
+		case "4":
 			fmt.Println("Exiting.")
 			return
 		default:
@@ -284,8 +423,33 @@ func main() {
 	}
 }
 
+func displayElections(elp *pb.ElectionListPayload) {
+	fmt.Println("\n--- Your Elections ---")
+	for _, e := range elp.Elections {
+		fmt.Printf("- %s (%s): %s", e.ElectionId, e.Title, e.State)
+		if e.VotingDeadline != "" {
+			fmt.Printf(", deadline %s", e.VotingDeadline)
+		}
+		fmt.Println()
+	}
+}
+
+// selectElection switches the menu to electionID if it is in the list. Cached candidates
+// belong to the previous election, so they are dropped.
+func selectElection(elp *pb.ElectionListPayload, electionID string) {
+	for _, e := range elp.Elections {
+		if e.ElectionId == electionID {
+			currentElectionID = e.ElectionId
+			currentCandidates = nil
+			fmt.Printf("Selected election %s (%s), currently %s.\n", e.ElectionId, e.Title, e.State)
+			return
+		}
+	}
+	fmt.Println("Unknown election ID.")
+}
+
 func displayResults(erp *pb.ElectionResultsPayload) {
-    fmt.Println("\n--- Election Results ---")
+    fmt.Printf("\n--- Election Results (%s) ---\n", erp.ElectionId)
     fmt.Printf("%s\n", erp.StatusMessage)
     fmt.Printf("Total Votes: %d\n", erp.TotalVotes)
     if len(erp.CandidateResults) == 0 && erp.TotalVotes == 0 {
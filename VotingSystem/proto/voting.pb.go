@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
 // source: voting.proto
 
 package proto
@@ -67,24 +67,90 @@ func (UserType) EnumDescriptor() ([]byte, []int) {
 	return file_voting_proto_rawDescGZIP(), []int{0}
 }
 
+// Election lifecycle: candidates are managed in DRAFT, votes are accepted while OPEN,
+// and results become visible to electors once PUBLISHED.
+type ElectionState int32
+
+const (
+	ElectionState_DRAFT     ElectionState = 0
+	ElectionState_OPEN      ElectionState = 1
+	ElectionState_CLOSED    ElectionState = 2
+	ElectionState_PUBLISHED ElectionState = 3
+)
+
+// Enum value maps for ElectionState.
+var (
+	ElectionState_name = map[int32]string{
+		0: "DRAFT",
+		1: "OPEN",
+		2: "CLOSED",
+		3: "PUBLISHED",
+	}
+	ElectionState_value = map[string]int32{
+		"DRAFT":     0,
+		"OPEN":      1,
+		"CLOSED":    2,
+		"PUBLISHED": 3,
+	}
+)
+
+func (x ElectionState) Enum() *ElectionState {
+	p := new(ElectionState)
+	*p = x
+	return p
+}
+
+func (x ElectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_voting_proto_enumTypes[1].Descriptor()
+}
+
+func (ElectionState) Type() protoreflect.EnumType {
+	return &file_voting_proto_enumTypes[1]
+}
+
+func (x ElectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElectionState.Descriptor instead.
+func (ElectionState) EnumDescriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{1}
+}
+
 type GenericRequest_Type int32
 
 const (
 	GenericRequest_LOGIN            GenericRequest_Type = 0
 	GenericRequest_GET_CANDIDATES   GenericRequest_Type = 1
 	GenericRequest_SUBMIT_VOTE      GenericRequest_Type = 2
-	GenericRequest_ADD_CANDIDATE    GenericRequest_Type = 3 // Admin
-	GenericRequest_REMOVE_CANDIDATE GenericRequest_Type = 4 // Admin
+	GenericRequest_ADD_CANDIDATE    GenericRequest_Type = 3  // Admin
+	GenericRequest_REMOVE_CANDIDATE GenericRequest_Type = 4  // Admin
+	GenericRequest_START_ELECTION   GenericRequest_Type = 5  // Admin
+	GenericRequest_EXTEND_DEADLINE  GenericRequest_Type = 6  // Admin
+	GenericRequest_CLOSE_NOW        GenericRequest_Type = 7  // Admin, no payload
+	GenericRequest_PUBLISH_RESULTS  GenericRequest_Type = 8  // Admin, no payload
+	GenericRequest_CREATE_ELECTION  GenericRequest_Type = 9  // Admin
+	GenericRequest_LIST_ELECTIONS   GenericRequest_Type = 10 // No payload
 )
 
 // Enum value maps for GenericRequest_Type.
 var (
 	GenericRequest_Type_name = map[int32]string{
-		0: "LOGIN",
-		1: "GET_CANDIDATES",
-		2: "SUBMIT_VOTE",
-		3: "ADD_CANDIDATE",
-		4: "REMOVE_CANDIDATE",
+		0:  "LOGIN",
+		1:  "GET_CANDIDATES",
+		2:  "SUBMIT_VOTE",
+		3:  "ADD_CANDIDATE",
+		4:  "REMOVE_CANDIDATE",
+		5:  "START_ELECTION",
+		6:  "EXTEND_DEADLINE",
+		7:  "CLOSE_NOW",
+		8:  "PUBLISH_RESULTS",
+		9:  "CREATE_ELECTION",
+		10: "LIST_ELECTIONS",
 	}
 	GenericRequest_Type_value = map[string]int32{
 		"LOGIN":            0,
@@ -92,6 +158,12 @@ var (
 		"SUBMIT_VOTE":      2,
 		"ADD_CANDIDATE":    3,
 		"REMOVE_CANDIDATE": 4,
+		"START_ELECTION":   5,
+		"EXTEND_DEADLINE":  6,
+		"CLOSE_NOW":        7,
+		"PUBLISH_RESULTS":  8,
+		"CREATE_ELECTION":  9,
+		"LIST_ELECTIONS":   10,
 	}
 )
 
@@ -106,11 +178,11 @@ func (x GenericRequest_Type) String() string {
 }
 
 func (GenericRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_voting_proto_enumTypes[1].Descriptor()
+	return file_voting_proto_enumTypes[2].Descriptor()
 }
 
 func (GenericRequest_Type) Type() protoreflect.EnumType {
-	return &file_voting_proto_enumTypes[1]
+	return &file_voting_proto_enumTypes[2]
 }
 
 func (x GenericRequest_Type) Number() protoreflect.EnumNumber {
@@ -132,6 +204,7 @@ const (
 	GenericResponse_VOTE_ACK              GenericResponse_Type = 4
 	GenericResponse_ADMIN_ACTION_ACK      GenericResponse_Type = 5
 	GenericResponse_ELECTION_RESULTS      GenericResponse_Type = 6
+	GenericResponse_ELECTION_LIST         GenericResponse_Type = 7
 )
 
 // Enum value maps for GenericResponse_Type.
@@ -144,6 +217,7 @@ var (
 		4: "VOTE_ACK",
 		5: "ADMIN_ACTION_ACK",
 		6: "ELECTION_RESULTS",
+		7: "ELECTION_LIST",
 	}
 	GenericResponse_Type_value = map[string]int32{
 		"GENERAL_STATUS":        0,
@@ -153,6 +227,7 @@ var (
 		"VOTE_ACK":              4,
 		"ADMIN_ACTION_ACK":      5,
 		"ELECTION_RESULTS":      6,
+		"ELECTION_LIST":         7,
 	}
 )
 
@@ -167,11 +242,11 @@ func (x GenericResponse_Type) String() string {
 }
 
 func (GenericResponse_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_voting_proto_enumTypes[2].Descriptor()
+	return file_voting_proto_enumTypes[3].Descriptor()
 }
 
 func (GenericResponse_Type) Type() protoreflect.EnumType {
-	return &file_voting_proto_enumTypes[2]
+	return &file_voting_proto_enumTypes[3]
 }
 
 func (x GenericResponse_Type) Number() protoreflect.EnumNumber {
@@ -261,9 +336,11 @@ type GenericRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    GenericRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=voting.GenericRequest_Type" json:"type,omitempty"`
-	Payload []byte              `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"` // Contains the serialized specific request message
-	Token   string              `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`     // Optional: for session management after login
+	Type       GenericRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=voting.GenericRequest_Type" json:"type,omitempty"`
+	Payload    []byte              `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`                         // Contains the serialized specific request message
+	Token      string              `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`                             // Optional: for session management after login
+	ElectionId string              `protobuf:"bytes,4,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"` // Election the request applies to; empty means the default election
+	RequestId  uint64              `protobuf:"varint,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`   // Chosen by the client, echoed on the response
 }
 
 func (x *GenericRequest) Reset() {
@@ -319,15 +396,33 @@ func (x *GenericRequest) GetToken() string {
 	return ""
 }
 
+func (x *GenericRequest) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
+func (x *GenericRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
 type GenericResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    GenericResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=voting.GenericResponse_Type" json:"type,omitempty"`
-	Payload []byte               `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"` // Contains the serialized specific response message
-	Message string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // General status message (e.g., error message)
-	Success bool                 `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Type          GenericResponse_Type `protobuf:"varint,1,opt,name=type,proto3,enum=voting.GenericResponse_Type" json:"type,omitempty"`
+	Payload       []byte               `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"` // Contains the serialized specific response message
+	Message       string               `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // General status message (e.g., error message)
+	Success       bool                 `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	ElectionState ElectionState        `protobuf:"varint,5,opt,name=election_state,json=electionState,proto3,enum=voting.ElectionState" json:"election_state,omitempty"` // State of election_id when the response was sent
+	ElectionId    string               `protobuf:"bytes,6,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`                                     // Election the response refers to; empty if none
+	Pushed        bool                 `protobuf:"varint,7,opt,name=pushed,proto3" json:"pushed,omitempty"`                                                              // Sent by the server on its own (e.g. results when voting ends), not in reply to a request
+	RequestId     uint64               `protobuf:"varint,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                                       // request_id of the request this answers; 0 for pushed frames
 }
 
 func (x *GenericResponse) Reset() {
@@ -390,6 +485,34 @@ func (x *GenericResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GenericResponse) GetElectionState() ElectionState {
+	if x != nil {
+		return x.ElectionState
+	}
+	return ElectionState_DRAFT
+}
+
+func (x *GenericResponse) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
+func (x *GenericResponse) GetPushed() bool {
+	if x != nil {
+		return x.Pushed
+	}
+	return false
+}
+
+func (x *GenericResponse) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
 // Specific Payloads for GenericRequest/GenericResponse
 type LoginPayload struct {
 	state         protoimpl.MessageState
@@ -461,6 +584,7 @@ type CandidateListPayload struct {
 
 	Candidates     []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
 	VotingDeadline string       `protobuf:"bytes,2,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"` // ISO 8601 format
+	ElectionId     string       `protobuf:"bytes,3,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
 }
 
 func (x *CandidateListPayload) Reset() {
@@ -509,6 +633,131 @@ func (x *CandidateListPayload) GetVotingDeadline() string {
 	return ""
 }
 
+func (x *CandidateListPayload) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
+type ElectionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionId     string        `protobuf:"bytes,1,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	Title          string        `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	State          ElectionState `protobuf:"varint,3,opt,name=state,proto3,enum=voting.ElectionState" json:"state,omitempty"`
+	VotingDeadline string        `protobuf:"bytes,4,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"` // ISO 8601 format; empty while in DRAFT
+}
+
+func (x *ElectionSummary) Reset() {
+	*x = ElectionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_voting_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionSummary) ProtoMessage() {}
+
+func (x *ElectionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionSummary.ProtoReflect.Descriptor instead.
+func (*ElectionSummary) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ElectionSummary) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
+func (x *ElectionSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ElectionSummary) GetState() ElectionState {
+	if x != nil {
+		return x.State
+	}
+	return ElectionState_DRAFT
+}
+
+func (x *ElectionSummary) GetVotingDeadline() string {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return ""
+}
+
+type ElectionListPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elections []*ElectionSummary `protobuf:"bytes,1,rep,name=elections,proto3" json:"elections,omitempty"`
+}
+
+func (x *ElectionListPayload) Reset() {
+	*x = ElectionListPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_voting_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionListPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionListPayload) ProtoMessage() {}
+
+func (x *ElectionListPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionListPayload.ProtoReflect.Descriptor instead.
+func (*ElectionListPayload) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ElectionListPayload) GetElections() []*ElectionSummary {
+	if x != nil {
+		return x.Elections
+	}
+	return nil
+}
+
 type SubmitVotePayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -521,7 +770,7 @@ type SubmitVotePayload struct {
 func (x *SubmitVotePayload) Reset() {
 	*x = SubmitVotePayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_voting_proto_msgTypes[5]
+		mi := &file_voting_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +783,7 @@ func (x *SubmitVotePayload) String() string {
 func (*SubmitVotePayload) ProtoMessage() {}
 
 func (x *SubmitVotePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_voting_proto_msgTypes[5]
+	mi := &file_voting_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +796,7 @@ func (x *SubmitVotePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitVotePayload.ProtoReflect.Descriptor instead.
 func (*SubmitVotePayload) Descriptor() ([]byte, []int) {
-	return file_voting_proto_rawDescGZIP(), []int{5}
+	return file_voting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubmitVotePayload) GetElectorId() string {
@@ -575,7 +824,7 @@ type AddCandidatePayload struct {
 func (x *AddCandidatePayload) Reset() {
 	*x = AddCandidatePayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_voting_proto_msgTypes[6]
+		mi := &file_voting_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +837,7 @@ func (x *AddCandidatePayload) String() string {
 func (*AddCandidatePayload) ProtoMessage() {}
 
 func (x *AddCandidatePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_voting_proto_msgTypes[6]
+	mi := &file_voting_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +850,7 @@ func (x *AddCandidatePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCandidatePayload.ProtoReflect.Descriptor instead.
 func (*AddCandidatePayload) Descriptor() ([]byte, []int) {
-	return file_voting_proto_rawDescGZIP(), []int{6}
+	return file_voting_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddCandidatePayload) GetCandidate() *Candidate {
@@ -622,7 +871,7 @@ type RemoveCandidatePayload struct {
 func (x *RemoveCandidatePayload) Reset() {
 	*x = RemoveCandidatePayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_voting_proto_msgTypes[7]
+		mi := &file_voting_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -635,7 +884,7 @@ func (x *RemoveCandidatePayload) String() string {
 func (*RemoveCandidatePayload) ProtoMessage() {}
 
 func (x *RemoveCandidatePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_voting_proto_msgTypes[7]
+	mi := &file_voting_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +897,7 @@ func (x *RemoveCandidatePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCandidatePayload.ProtoReflect.Descriptor instead.
 func (*RemoveCandidatePayload) Descriptor() ([]byte, []int) {
-	return file_voting_proto_rawDescGZIP(), []int{7}
+	return file_voting_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RemoveCandidatePayload) GetCandidateId() string {
@@ -658,6 +907,163 @@ func (x *RemoveCandidatePayload) GetCandidateId() string {
 	return ""
 }
 
+type CreateElectionPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionId         string   `protobuf:"bytes,1,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	Title              string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EligibleElectorIds []string `protobuf:"bytes,3,rep,name=eligible_elector_ids,json=eligibleElectorIds,proto3" json:"eligible_elector_ids,omitempty"` // Empty means every registered elector
+}
+
+func (x *CreateElectionPayload) Reset() {
+	*x = CreateElectionPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_voting_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateElectionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateElectionPayload) ProtoMessage() {}
+
+func (x *CreateElectionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateElectionPayload.ProtoReflect.Descriptor instead.
+func (*CreateElectionPayload) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateElectionPayload) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
+func (x *CreateElectionPayload) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateElectionPayload) GetEligibleElectorIds() []string {
+	if x != nil {
+		return x.EligibleElectorIds
+	}
+	return nil
+}
+
+type StartElectionPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VotingDeadline string `protobuf:"bytes,1,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"` // ISO 8601 format; empty uses the server's default duration
+}
+
+func (x *StartElectionPayload) Reset() {
+	*x = StartElectionPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_voting_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartElectionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartElectionPayload) ProtoMessage() {}
+
+func (x *StartElectionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartElectionPayload.ProtoReflect.Descriptor instead.
+func (*StartElectionPayload) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartElectionPayload) GetVotingDeadline() string {
+	if x != nil {
+		return x.VotingDeadline
+	}
+	return ""
+}
+
+type ExtendDeadlinePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewDeadline string `protobuf:"bytes,1,opt,name=new_deadline,json=newDeadline,proto3" json:"new_deadline,omitempty"` // ISO 8601 format; must be later than the current deadline
+}
+
+func (x *ExtendDeadlinePayload) Reset() {
+	*x = ExtendDeadlinePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_voting_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendDeadlinePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendDeadlinePayload) ProtoMessage() {}
+
+func (x *ExtendDeadlinePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_voting_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendDeadlinePayload.ProtoReflect.Descriptor instead.
+func (*ExtendDeadlinePayload) Descriptor() ([]byte, []int) {
+	return file_voting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExtendDeadlinePayload) GetNewDeadline() string {
+	if x != nil {
+		return x.NewDeadline
+	}
+	return ""
+}
+
 type ElectionResultsPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -667,12 +1073,13 @@ type ElectionResultsPayload struct {
 	CandidateResults []*Candidate `protobuf:"bytes,2,rep,name=candidate_results,json=candidateResults,proto3" json:"candidate_results,omitempty"`
 	Winner           *Candidate   `protobuf:"bytes,3,opt,name=winner,proto3" json:"winner,omitempty"`
 	StatusMessage    string       `protobuf:"bytes,4,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	ElectionId       string       `protobuf:"bytes,5,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
 }
 
 func (x *ElectionResultsPayload) Reset() {
 	*x = ElectionResultsPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_voting_proto_msgTypes[8]
+		mi := &file_voting_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +1092,7 @@ func (x *ElectionResultsPayload) String() string {
 func (*ElectionResultsPayload) ProtoMessage() {}
 
 func (x *ElectionResultsPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_voting_proto_msgTypes[8]
+	mi := &file_voting_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1105,7 @@ func (x *ElectionResultsPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ElectionResultsPayload.ProtoReflect.Descriptor instead.
 func (*ElectionResultsPayload) Descriptor() ([]byte, []int) {
-	return file_voting_proto_rawDescGZIP(), []int{8}
+	return file_voting_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ElectionResultsPayload) GetTotalVotes() int32 {
@@ -729,6 +1136,13 @@ func (x *ElectionResultsPayload) GetStatusMessage() string {
 	return ""
 }
 
+func (x *ElectionResultsPayload) GetElectionId() string {
+	if x != nil {
+		return x.ElectionId
+	}
+	return ""
+}
+
 // For UDP Multicast (Informative Note from Admin)
 type InformativeNote struct {
 	state         protoimpl.MessageState
@@ -743,7 +1157,7 @@ type InformativeNote struct {
 func (x *InformativeNote) Reset() {
 	*x = InformativeNote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_voting_proto_msgTypes[9]
+		mi := &file_voting_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -756,7 +1170,7 @@ func (x *InformativeNote) String() string {
 func (*InformativeNote) ProtoMessage() {}
 
 func (x *InformativeNote) ProtoReflect() protoreflect.Message {
-	mi := &file_voting_proto_msgTypes[9]
+	mi := &file_voting_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +1183,7 @@ func (x *InformativeNote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InformativeNote.ProtoReflect.Descriptor instead.
 func (*InformativeNote) Descriptor() ([]byte, []int) {
-	return file_voting_proto_rawDescGZIP(), []int{9}
+	return file_voting_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *InformativeNote) GetAdminId() string {
@@ -804,39 +1218,61 @@ var file_voting_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x6f, 0x74,
 	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
 	0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x89, 0x03, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72,
 	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
 	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
 	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
 	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
 	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x53, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x5f, 0x56, 0x4f, 0x54, 0x45,
-	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x44, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44,
-	0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f,
-	0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x22, 0xb0, 0x02, 0x0a, 0x0f,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x9c, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x45, 0x4e, 0x45,
-	0x52, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
-	0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x4c,
-	0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x49, 0x4e,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02,
-	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
-	0x53, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x4b,
-	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4c, 0x45, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x06, 0x22, 0x72,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x53,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x5f, 0x56, 0x4f, 0x54,
+	0x45, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x44, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05,
+	0x12, 0x13, 0x0a, 0x0f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x4e,
+	0x4f, 0x57, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x12,
+	0x0a, 0x0e, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53,
+	0x10, 0x0a, 0x22, 0xd9, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x47,
+	0x49, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10,
+	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x12,
+	0x14, 0x0a, 0x10, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x43, 0x4b, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x53, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x07, 0x22, 0x72,
 	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17,
 	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
@@ -844,51 +1280,90 @@ var file_voting_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
 	0x55, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x46, 0x0a,
-	0x13, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3e,
-	0x0a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63, 0x61,
-	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29,
-	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x64, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e,
-	0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x22, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x15, 0x5a, 0x13, 0x76, 0x6f,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x4c, 0x0a, 0x13, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x35, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x46,
+	0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3a, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x3e, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x10, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x64, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x22, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x0d,
+	0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x42, 0x15, 0x5a,
+	0x13, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -903,36 +1378,45 @@ func file_voting_proto_rawDescGZIP() []byte {
 	return file_voting_proto_rawDescData
 }
 
-var file_voting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_voting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_voting_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_voting_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_voting_proto_goTypes = []interface{}{
 	(UserType)(0),                  // 0: voting.UserType
-	(GenericRequest_Type)(0),       // 1: voting.GenericRequest.Type
-	(GenericResponse_Type)(0),      // 2: voting.GenericResponse.Type
-	(*Candidate)(nil),              // 3: voting.Candidate
-	(*GenericRequest)(nil),         // 4: voting.GenericRequest
-	(*GenericResponse)(nil),        // 5: voting.GenericResponse
-	(*LoginPayload)(nil),           // 6: voting.LoginPayload
-	(*CandidateListPayload)(nil),   // 7: voting.CandidateListPayload
-	(*SubmitVotePayload)(nil),      // 8: voting.SubmitVotePayload
-	(*AddCandidatePayload)(nil),    // 9: voting.AddCandidatePayload
-	(*RemoveCandidatePayload)(nil), // 10: voting.RemoveCandidatePayload
-	(*ElectionResultsPayload)(nil), // 11: voting.ElectionResultsPayload
-	(*InformativeNote)(nil),        // 12: voting.InformativeNote
+	(ElectionState)(0),             // 1: voting.ElectionState
+	(GenericRequest_Type)(0),       // 2: voting.GenericRequest.Type
+	(GenericResponse_Type)(0),      // 3: voting.GenericResponse.Type
+	(*Candidate)(nil),              // 4: voting.Candidate
+	(*GenericRequest)(nil),         // 5: voting.GenericRequest
+	(*GenericResponse)(nil),        // 6: voting.GenericResponse
+	(*LoginPayload)(nil),           // 7: voting.LoginPayload
+	(*CandidateListPayload)(nil),   // 8: voting.CandidateListPayload
+	(*ElectionSummary)(nil),        // 9: voting.ElectionSummary
+	(*ElectionListPayload)(nil),    // 10: voting.ElectionListPayload
+	(*SubmitVotePayload)(nil),      // 11: voting.SubmitVotePayload
+	(*AddCandidatePayload)(nil),    // 12: voting.AddCandidatePayload
+	(*RemoveCandidatePayload)(nil), // 13: voting.RemoveCandidatePayload
+	(*CreateElectionPayload)(nil),  // 14: voting.CreateElectionPayload
+	(*StartElectionPayload)(nil),   // 15: voting.StartElectionPayload
+	(*ExtendDeadlinePayload)(nil),  // 16: voting.ExtendDeadlinePayload
+	(*ElectionResultsPayload)(nil), // 17: voting.ElectionResultsPayload
+	(*InformativeNote)(nil),        // 18: voting.InformativeNote
 }
 var file_voting_proto_depIdxs = []int32{
-	1, // 0: voting.GenericRequest.type:type_name -> voting.GenericRequest.Type
-	2, // 1: voting.GenericResponse.type:type_name -> voting.GenericResponse.Type
-	0, // 2: voting.LoginPayload.user_type:type_name -> voting.UserType
-	3, // 3: voting.CandidateListPayload.candidates:type_name -> voting.Candidate
-	3, // 4: voting.AddCandidatePayload.candidate:type_name -> voting.Candidate
-	3, // 5: voting.ElectionResultsPayload.candidate_results:type_name -> voting.Candidate
-	3, // 6: voting.ElectionResultsPayload.winner:type_name -> voting.Candidate
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: voting.GenericRequest.type:type_name -> voting.GenericRequest.Type
+	3,  // 1: voting.GenericResponse.type:type_name -> voting.GenericResponse.Type
+	1,  // 2: voting.GenericResponse.election_state:type_name -> voting.ElectionState
+	0,  // 3: voting.LoginPayload.user_type:type_name -> voting.UserType
+	4,  // 4: voting.CandidateListPayload.candidates:type_name -> voting.Candidate
+	1,  // 5: voting.ElectionSummary.state:type_name -> voting.ElectionState
+	9,  // 6: voting.ElectionListPayload.elections:type_name -> voting.ElectionSummary
+	4,  // 7: voting.AddCandidatePayload.candidate:type_name -> voting.Candidate
+	4,  // 8: voting.ElectionResultsPayload.candidate_results:type_name -> voting.Candidate
+	4,  // 9: voting.ElectionResultsPayload.winner:type_name -> voting.Candidate
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_voting_proto_init() }
@@ -1002,7 +1486,7 @@ func file_voting_proto_init() {
 			}
 		}
 		file_voting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitVotePayload); i {
+			switch v := v.(*ElectionSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1014,7 +1498,7 @@ func file_voting_proto_init() {
 			}
 		}
 		file_voting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCandidatePayload); i {
+			switch v := v.(*ElectionListPayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1026,7 +1510,7 @@ func file_voting_proto_init() {
 			}
 		}
 		file_voting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCandidatePayload); i {
+			switch v := v.(*SubmitVotePayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1038,7 +1522,7 @@ func file_voting_proto_init() {
 			}
 		}
 		file_voting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ElectionResultsPayload); i {
+			switch v := v.(*AddCandidatePayload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1050,6 +1534,66 @@ func file_voting_proto_init() {
 			}
 		}
 		file_voting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCandidatePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_voting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateElectionPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_voting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartElectionPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_voting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendDeadlinePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_voting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionResultsPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_voting_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InformativeNote); i {
 			case 0:
 				return &v.state
@@ -1067,8 +1611,8 @@ func file_voting_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_voting_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Journal entry types. Every state change that must survive a crash is written
+// (and fsynced) before the client is acknowledged.
+const (
+	JOURNAL_ELECTION_CREATED  = "ELECTION_CREATED"
+	JOURNAL_VOTING_STARTED    = "VOTING_STARTED"
+	JOURNAL_DEADLINE_EXTENDED = "DEADLINE_EXTENDED"
+	JOURNAL_CANDIDATE_ADDED   = "CANDIDATE_ADDED"
+	JOURNAL_CANDIDATE_REMOVED = "CANDIDATE_REMOVED"
+	JOURNAL_VOTE              = "VOTE"
+	JOURNAL_VOTING_ENDED      = "VOTING_ENDED"
+	JOURNAL_RESULTS_PUBLISHED = "RESULTS_PUBLISHED"
+)
+
+type journalEntry struct {
+	Type             string   `json:"type"`
+	ElectionID       string   `json:"election_id,omitempty"` // Empty in journals written before multiple elections: the default election
+	Title            string   `json:"title,omitempty"`       // Only for ELECTION_CREATED
+	EligibleElectors []string `json:"eligible_electors,omitempty"`
+	Deadline         string   `json:"deadline,omitempty"` // RFC 3339, only for VOTING_STARTED and DEADLINE_EXTENDED
+	CandidateID      string   `json:"candidate_id,omitempty"`
+	CandidateName    string   `json:"candidate_name,omitempty"`
+	ElectorID        string   `json:"elector_id,omitempty"`
+}
+
+// voteJournal is an append-only, newline-delimited JSON log of election events.
+type voteJournal struct {
+	file *os.File
+}
+
+// openVoteJournal opens (or creates) the journal at path and returns every complete
+// entry already in it. A torn final line left by a crash mid-write is discarded.
+func openVoteJournal(path string) (*voteJournal, []journalEntry, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open vote journal: %w", err)
+	}
+
+	var entries []journalEntry
+	reader := bufio.NewReader(file)
+	validBytes := int64(0)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// Anything after the last newline is an incomplete write.
+			break
+		}
+		if err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("failed to read vote journal: %w", err)
+		}
+		var entry journalEntry
+		if err := json.Unmarshal(bytes.TrimSpace(line), &entry); err != nil {
+			file.Close()
+			return nil, nil, fmt.Errorf("corrupt vote journal entry at byte %d: %w", validBytes, err)
+		}
+		entries = append(entries, entry)
+		validBytes += int64(len(line))
+	}
+
+	if err := file.Truncate(validBytes); err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to discard torn vote journal entry: %w", err)
+	}
+	if _, err := file.Seek(validBytes, io.SeekStart); err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to seek vote journal: %w", err)
+	}
+	return &voteJournal{file: file}, entries, nil
+}
+
+// append writes the entry and fsyncs it; only then is the change considered durable.
+func (j *voteJournal) append(entry journalEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry: %w", err)
+	}
+	data = append(data, '\n')
+	if _, err := j.file.Write(data); err != nil {
+		return fmt.Errorf("failed to write journal entry: %w", err)
+	}
+	if err := j.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync journal: %w", err)
+	}
+	return nil
+}
+
+func (j *voteJournal) Close() error {
+	return j.file.Close()
+}
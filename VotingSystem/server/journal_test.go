@@ -0,0 +1,148 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "voting_system/proto"
+)
+
+func writeJournal(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "votes.journal")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestOpenVoteJournalDiscardsTornTail(t *testing.T) {
+	complete := `{"type":"CANDIDATE_ADDED","candidate_id":"c1","candidate_name":"Alpha"}` + "\n" +
+		`{"type":"CANDIDATE_ADDED","candidate_id":"c2","candidate_name":"Beta"}` + "\n"
+	path := writeJournal(t, complete+`{"type":"VOTE","candidate_id":"c`)
+
+	journal, entries, err := openVoteJournal(path)
+	if err != nil {
+		t.Fatalf("openVoteJournal: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != int64(len(complete)) {
+		t.Fatalf("journal is %d bytes after recovery, want %d", info.Size(), len(complete))
+	}
+
+	// New entries go right after the last complete one.
+	if err := journal.append(journalEntry{Type: JOURNAL_CANDIDATE_REMOVED, CandidateID: "c2"}); err != nil {
+		t.Fatal(err)
+	}
+	journal.Close()
+	journal, entries, err = openVoteJournal(path)
+	if err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	defer journal.Close()
+	if len(entries) != 3 || entries[2].Type != JOURNAL_CANDIDATE_REMOVED {
+		t.Fatalf("entries after reopening = %+v", entries)
+	}
+}
+
+func TestOpenVoteJournalRejectsCorruptMiddleLine(t *testing.T) {
+	path := writeJournal(t, `{"type":"CANDIDATE_ADDED","candidate_id":"c1","candidate_name":"Alpha"}`+"\n"+
+		"not json\n"+
+		`{"type":"CANDIDATE_ADDED","candidate_id":"c2","candidate_name":"Beta"}`+"\n")
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := openVoteJournal(path); err == nil || !strings.Contains(err.Error(), "corrupt vote journal entry") {
+		t.Fatalf("openVoteJournal error = %v, want a corrupt entry error", err)
+	}
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(after) != string(before) {
+		t.Fatal("a corrupt journal must be left untouched")
+	}
+}
+
+func TestOpenJournalRestoresElections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "votes.journal")
+	journal, _, err := openVoteJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+	extended := deadline.Add(30 * time.Minute)
+	for _, entry := range []journalEntry{
+		// Legacy entries without an election ID belong to the default election.
+		{Type: JOURNAL_CANDIDATE_ADDED, CandidateID: "c1", CandidateName: "Alpha"},
+		{Type: JOURNAL_CANDIDATE_ADDED, CandidateID: "c2", CandidateName: "Beta"},
+		{Type: JOURNAL_VOTING_STARTED, Deadline: deadline.Format(time.RFC3339Nano)},
+		{Type: JOURNAL_DEADLINE_EXTENDED, Deadline: extended.Format(time.RFC3339Nano)},
+		{Type: JOURNAL_VOTE, CandidateID: "c1", ElectorID: "elector1"},
+		{Type: JOURNAL_ELECTION_CREATED, ElectionID: "board", Title: "Board", EligibleElectors: []string{"elector2"}},
+		{Type: JOURNAL_CANDIDATE_ADDED, ElectionID: "board", CandidateID: "b1", CandidateName: "Gamma"},
+		{Type: JOURNAL_VOTING_STARTED, ElectionID: "board", Deadline: deadline.Format(time.RFC3339Nano)},
+		{Type: JOURNAL_VOTE, ElectionID: "board", CandidateID: "b1", ElectorID: "elector2"},
+		{Type: JOURNAL_VOTING_ENDED, ElectionID: "board"},
+	} {
+		if err := journal.append(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	journal.Close()
+
+	s := NewServer()
+	if err := s.OpenJournal(path); err != nil {
+		t.Fatalf("OpenJournal: %v", err)
+	}
+	defer s.journal.Close()
+
+	def := s.elections[DEFAULT_ELECTION_ID]
+	if def == nil {
+		t.Fatal("default election not restored")
+	}
+	if def.state != pb.ElectionState_OPEN {
+		t.Errorf("default election is %s, want OPEN", def.state)
+	}
+	if !def.votingDeadline.Equal(extended) {
+		t.Errorf("deadline = %s, want the extended %s", def.votingDeadline, extended)
+	}
+	if def.votes["c1"] != 1 || def.votes["c2"] != 0 || def.candidates["c1"].VoteCount != 1 {
+		t.Errorf("tally = %v, want c1=1 c2=0", def.votes)
+	}
+	if !def.voted["elector1"] || def.voted["elector2"] {
+		t.Errorf("voted = %v, want only elector1", def.voted)
+	}
+
+	board := s.elections["board"]
+	if board == nil {
+		t.Fatal("board election not restored")
+	}
+	if board.state != pb.ElectionState_CLOSED || board.electionResults == nil {
+		t.Fatalf("board election is %s with results %v, want CLOSED with results", board.state, board.electionResults)
+	}
+	if board.isEligibleLocked("elector1") || !board.isEligibleLocked("elector2") {
+		t.Error("board eligibility not restored")
+	}
+	if got := board.electionResults.CandidateResults; len(got) != 1 || got[0].VoteCount != 1 {
+		t.Errorf("board results = %v, want b1 with 1 vote", got)
+	}
+}
+
+func TestOpenJournalRejectsVoteForUnknownCandidate(t *testing.T) {
+	path := writeJournal(t, `{"type":"VOTE","candidate_id":"ghost","elector_id":"elector1"}`+"\n")
+	if err := NewServer().OpenJournal(path); err == nil {
+		t.Fatal("OpenJournal accepted a vote for a candidate that was never added")
+	}
+}
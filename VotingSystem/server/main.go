@@ -2,11 +2,15 @@ package main
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -15,27 +19,46 @@ import (
 
 const (
 	TCP_PORT        = ":8080"
-	VOTING_DURATION = 5 * time.Minute
+	VOTING_DURATION = 5 * time.Minute // Used when START_ELECTION carries no deadline
 	MAX_MSG_SIZE    = 4096
+	JOURNAL_PATH    = "votes.journal"
+
+	PUSH_WRITE_TIMEOUT = 5 * time.Second // A client that stops reading cannot stall a broadcast
+
+	// The default election serves requests without an election ID and journal entries
+	// written before the server hosted multiple elections.
+	DEFAULT_ELECTION_ID    = "default"
+	DEFAULT_ELECTION_TITLE = "Default Election"
 )
 
 type User struct {
 	ID       string
 	Password string
 	UserType pb.UserType
-	HasVoted bool
 	Conn     net.Conn
 }
 
-type Server struct {
-	listener        net.Listener
-	users           map[string]*User
+// Election is one independent ballot hosted by the server. Its fields are guarded by Server.mu.
+type Election struct {
+	ID              string
+	Title           string
 	candidates      map[string]*pb.Candidate
 	votes           map[string]int32 // candidateID -> vote count (simplified from full candidate for tally)
+	eligible        map[string]bool  // Elector IDs allowed to vote; nil means every elector
+	voted           map[string]bool  // Elector IDs that have voted in this election
 	votingDeadline  time.Time
-	isVotingOpen    bool
+	votingTimer     *time.Timer // Fires at votingDeadline; replaced when the deadline is extended
+	state           pb.ElectionState
 	electionResults *pb.ElectionResultsPayload
-	mu              sync.Mutex
+}
+
+type Server struct {
+	listener   net.Listener
+	users      map[string]*User
+	elections  map[string]*Election
+	journal    *voteJournal             // nil means no persistence
+	writeLocks map[net.Conn]*sync.Mutex // One per open connection, so frames written to it never interleave
+	mu         sync.Mutex
 }
 
 func NewServer() *Server {
@@ -45,12 +68,33 @@ func NewServer() *Server {
 			"elector2": {ID: "elector2", Password: "password", UserType: pb.UserType_ELECTOR},
 			"admin1":   {ID: "admin1", Password: "adminpass", UserType: pb.UserType_ADMIN},
 		},
-		candidates:   make(map[string]*pb.Candidate),
-		votes:        make(map[string]int32),
-		isVotingOpen: false,
+		elections:  make(map[string]*Election),
+		writeLocks: make(map[net.Conn]*sync.Mutex),
 	}
 }
 
+func newElection(id, title string, eligibleElectors []string) *Election {
+	e := &Election{
+		ID:         id,
+		Title:      title,
+		candidates: make(map[string]*pb.Candidate),
+		votes:      make(map[string]int32),
+		voted:      make(map[string]bool),
+		state:      pb.ElectionState_DRAFT,
+	}
+	if len(eligibleElectors) > 0 {
+		e.eligible = make(map[string]bool, len(eligibleElectors))
+		for _, electorID := range eligibleElectors {
+			e.eligible[electorID] = true
+		}
+	}
+	return e
+}
+
+func (e *Election) isEligibleLocked(electorID string) bool { // For use when s.mu is already locked
+	return e.eligible == nil || e.eligible[electorID]
+}
+
 func (s *Server) Start() {
 	var err error
 	s.listener, err = net.Listen("tcp", TCP_PORT)
@@ -60,7 +104,21 @@ func (s *Server) Start() {
 	defer s.listener.Close()
 	log.Printf("Server listening on %s", TCP_PORT)
 
-	s.startVotingPeriod(VOTING_DURATION)
+	// Voting is opened by an admin with START_ELECTION; only periods restored from the
+	// journal are resumed here.
+	s.mu.Lock()
+	for _, e := range s.sortedElectionsLocked() {
+		switch e.state {
+		case pb.ElectionState_DRAFT:
+			log.Printf("Election %s is in DRAFT. Waiting for an admin to start it.", e.ID)
+		case pb.ElectionState_OPEN:
+			log.Printf("Resuming voting in election %s restored from journal. Deadline: %s", e.ID, e.votingDeadline.Format(time.RFC3339))
+			s.scheduleVotingEndLocked(e, e.votingDeadline)
+		default:
+			log.Printf("Election %s restored from journal is %s.", e.ID, e.state)
+		}
+	}
+	s.mu.Unlock()
 
 	for {
 		conn, err := s.listener.Accept()
@@ -73,40 +131,236 @@ func (s *Server) Start() {
 	}
 }
 
-func (s *Server) startVotingPeriod(duration time.Duration) {
+// electionLocked returns the election with the given ID.
+func (s *Server) electionLocked(id string) (*Election, error) { // For use when s.mu is already locked
+	e, exists := s.elections[id]
+	if !exists {
+		return nil, fmt.Errorf("unknown election %q", id)
+	}
+	return e, nil
+}
+
+func (s *Server) sortedElectionsLocked() []*Election { // For use when s.mu is already locked
+	elections := make([]*Election, 0, len(s.elections))
+	for _, e := range s.elections {
+		elections = append(elections, e)
+	}
+	sort.Slice(elections, func(i, j int) bool { return elections[i].ID < elections[j].ID })
+	return elections
+}
+
+func (s *Server) createElectionLocked(id, title string, eligibleElectors []string) (*Election, error) {
+	if id == "" {
+		return nil, errors.New("the election ID cannot be empty")
+	}
+	if _, exists := s.elections[id]; exists {
+		return nil, errors.New("the election ID already exists")
+	}
+	for _, electorID := range eligibleElectors {
+		if user, ok := s.users[electorID]; !ok || user.UserType != pb.UserType_ELECTOR {
+			return nil, fmt.Errorf("%q is not a registered elector", electorID)
+		}
+	}
+	if title == "" {
+		title = id
+	}
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_ELECTION_CREATED, ElectionID: id, Title: title, EligibleElectors: eligibleElectors}); err != nil {
+		log.Printf("Failed to journal new election %s: %v", id, err)
+		return nil, errors.New("failed to record the election")
+	}
+	e := newElection(id, title, eligibleElectors)
+	s.elections[id] = e
+	return e, nil
+}
+
+func (s *Server) startVotingPeriod(electionID string, deadline time.Time) error {
 	s.mu.Lock()
-	if s.isVotingOpen {
-		s.mu.Unlock()
-		log.Println("Voting is already open.")
-		return
+	defer s.mu.Unlock()
+
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		return err
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_START_ELECTION); err != nil {
+		return err
+	}
+	if len(e.candidates) == 0 {
+		return errors.New("there are no candidates")
+	}
+	if !deadline.After(time.Now()) {
+		return errors.New("the deadline must be in the future")
+	}
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_VOTING_STARTED, ElectionID: e.ID, Deadline: deadline.Format(time.RFC3339Nano)}); err != nil {
+		log.Printf("Failed to journal voting start in election %s, voting not opened: %v", e.ID, err)
+		return errors.New("failed to record the start of voting")
+	}
+	e.openVotingLocked(deadline)
+	s.scheduleVotingEndLocked(e, deadline)
+	log.Printf("Voting started in election %s. Deadline: %s", e.ID, e.votingDeadline.Format(time.RFC3339))
+	return nil
+}
+
+func (s *Server) extendDeadline(electionID string, deadline time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		return err
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_EXTEND_DEADLINE); err != nil {
+		return err
 	}
-	s.isVotingOpen = true
-	s.votingDeadline = time.Now().Add(duration)
-	s.votes = make(map[string]int32) // Reset votes for candidates
-	for id := range s.candidates { // Reset vote counts in candidate objects too
-		if cand, ok := s.candidates[id]; ok {
+	if !deadline.After(e.votingDeadline) {
+		return fmt.Errorf("the new deadline must be later than the current one (%s)", e.votingDeadline.Format(time.RFC3339))
+	}
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_DEADLINE_EXTENDED, ElectionID: e.ID, Deadline: deadline.Format(time.RFC3339Nano)}); err != nil {
+		log.Printf("Failed to journal deadline extension in election %s: %v", e.ID, err)
+		return errors.New("failed to record the new deadline")
+	}
+	e.votingDeadline = deadline
+	s.scheduleVotingEndLocked(e, deadline)
+	log.Printf("Voting deadline of election %s extended to %s", e.ID, deadline.Format(time.RFC3339))
+	return nil
+}
+
+// openVotingLocked resets the tally and elector flags for a new voting period. The
+// state is set directly rather than through transitionLocked so that journals written
+// before the state machine existed, with several voting periods, still replay.
+func (e *Election) openVotingLocked(deadline time.Time) {
+	e.state = pb.ElectionState_OPEN
+	e.votingDeadline = deadline
+	e.votes = make(map[string]int32) // Reset votes for candidates
+	for id := range e.candidates { // Reset vote counts in candidate objects too
+		if cand, ok := e.candidates[id]; ok {
 			cand.VoteCount = 0
 			cand.Percentage = 0
 		}
 	}
-	for _, u := range s.users { // Reset elector voted status
-		if u.UserType == pb.UserType_ELECTOR {
-			u.HasVoted = false
+	e.voted = make(map[string]bool) // Reset elector voted status
+	e.electionResults = nil // Clear previous results
+}
+
+// scheduleVotingEndLocked replaces any pending timer so that voting in e closes at deadline.
+func (s *Server) scheduleVotingEndLocked(e *Election, deadline time.Time) {
+	if e.votingTimer != nil {
+		e.votingTimer.Stop()
+	}
+	electionID := e.ID
+	e.votingTimer = time.AfterFunc(time.Until(deadline), func() {
+		s.endVotingAndCalculateResults(electionID, deadline, nil)
+	})
+}
+
+// OpenJournal replays the vote journal at path into the server state and keeps it
+// open so that subsequent changes are journaled before being acknowledged.
+func (s *Server) OpenJournal(path string) error {
+	journal, entries, err := openVoteJournal(path)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, entry := range entries {
+		if err := s.applyJournalEntryLocked(entry); err != nil {
+			journal.Close()
+			return fmt.Errorf("failed to replay journal entry %d (%s): %w", i+1, entry.Type, err)
 		}
 	}
-	s.electionResults = nil // Clear previous results
-	log.Printf("Voting started. Deadline: %s", s.votingDeadline.Format(time.RFC3339))
-	s.mu.Unlock()
+	s.journal = journal
+	log.Printf("Replayed %d journal entries from %s", len(entries), path)
+	return nil
+}
+
+func (s *Server) applyJournalEntryLocked(entry journalEntry) error {
+	electionID := entry.ElectionID
+	if electionID == "" {
+		electionID = DEFAULT_ELECTION_ID
+	}
+	if entry.Type == JOURNAL_ELECTION_CREATED {
+		if _, exists := s.elections[electionID]; exists {
+			return fmt.Errorf("election %q created twice", electionID)
+		}
+		s.elections[electionID] = newElection(electionID, entry.Title, entry.EligibleElectors)
+		return nil
+	}
+	e, exists := s.elections[electionID]
+	if !exists {
+		if electionID != DEFAULT_ELECTION_ID {
+			return fmt.Errorf("entry for unknown election %q", electionID)
+		}
+		// Journals written before multiple elections never created the default election.
+		e = newElection(DEFAULT_ELECTION_ID, DEFAULT_ELECTION_TITLE, nil)
+		s.elections[e.ID] = e
+	}
 
-	go func() {
-		timer := time.NewTimer(time.Until(s.votingDeadline))
-		<-timer.C
-		s.endVotingAndCalculateResults()
-	}()
+	switch entry.Type {
+	case JOURNAL_VOTING_STARTED:
+		deadline, err := time.Parse(time.RFC3339Nano, entry.Deadline)
+		if err != nil {
+			return fmt.Errorf("invalid deadline %q: %w", entry.Deadline, err)
+		}
+		e.openVotingLocked(deadline)
+	case JOURNAL_DEADLINE_EXTENDED:
+		deadline, err := time.Parse(time.RFC3339Nano, entry.Deadline)
+		if err != nil {
+			return fmt.Errorf("invalid deadline %q: %w", entry.Deadline, err)
+		}
+		e.votingDeadline = deadline
+	case JOURNAL_CANDIDATE_ADDED:
+		e.candidates[entry.CandidateID] = &pb.Candidate{Id: entry.CandidateID, Name: entry.CandidateName}
+		e.votes[entry.CandidateID] = 0
+	case JOURNAL_CANDIDATE_REMOVED:
+		delete(e.candidates, entry.CandidateID)
+		delete(e.votes, entry.CandidateID)
+	case JOURNAL_VOTE:
+		candidate, exists := e.candidates[entry.CandidateID]
+		if !exists {
+			return fmt.Errorf("vote for unknown candidate %q", entry.CandidateID)
+		}
+		candidate.VoteCount++
+		e.votes[candidate.Id]++
+		e.voted[entry.ElectorID] = true
+	case JOURNAL_VOTING_ENDED:
+		e.state = pb.ElectionState_CLOSED
+		e.electionResults = e.calculateResultsLocked()
+	case JOURNAL_RESULTS_PUBLISHED:
+		if err := e.transitionLocked(pb.ElectionState_PUBLISHED); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown journal entry type %q", entry.Type)
+	}
+	return nil
+}
+
+func (s *Server) recordLocked(entry journalEntry) error { // For use when s.mu is already locked
+	if s.journal == nil {
+		return nil
+	}
+	return s.journal.append(entry)
+}
+
+// forgetConnection marks the users logged in on conn as disconnected, whichever way the
+// connection ended, so pushes stop going to it and the user can log in again.
+func (s *Server) forgetConnection(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.writeLocks, conn)
+	for _, user := range s.users {
+		if user.Conn == conn {
+			user.Conn = nil
+		}
+	}
 }
 
 func (s *Server) handleConnection(conn net.Conn) {
+	s.mu.Lock()
+	s.writeLocks[conn] = &sync.Mutex{}
+	s.mu.Unlock()
 	defer conn.Close()
+	defer s.forgetConnection(conn)
 	var loggedInUser *User // To track which user is on this connection
 
 	for {
@@ -114,11 +368,6 @@ func (s *Server) handleConnection(conn net.Conn) {
 		if err := binary.Read(conn, binary.BigEndian, &msgLen); err != nil {
 			if err == io.EOF {
 				log.Printf("Client %s disconnected", conn.RemoteAddr())
-				if loggedInUser != nil {
-					s.mu.Lock()
-					loggedInUser.Conn = nil // Mark as disconnected
-					s.mu.Unlock()
-				}
 				return
 			}
 			log.Printf("Error reading message length from %s: %v", conn.RemoteAddr(), err)
@@ -127,7 +376,7 @@ func (s *Server) handleConnection(conn net.Conn) {
 
 		if msgLen > MAX_MSG_SIZE {
 			log.Printf("Message from %s too large: %d bytes. Closing connection.", conn.RemoteAddr(), msgLen)
-			s.sendErrorResponse(conn, "Message too large.")
+			s.sendErrorResponse(conn, 0, "", "Message too large.")
 			return
 		}
 
@@ -140,277 +389,601 @@ func (s *Server) handleConnection(conn net.Conn) {
 		req := &pb.GenericRequest{}
 		if err := proto.Unmarshal(msgBytes, req); err != nil {
 			log.Printf("Failed to unmarshal request from %s: %v", conn.RemoteAddr(), err)
-			s.sendErrorResponse(conn, "Invalid request format")
+			s.sendErrorResponse(conn, 0, "", "Invalid request format")
 			continue
 		}
 
-		log.Printf("Received %s request from %s", req.Type, conn.RemoteAddr())
+		electionID := req.ElectionId
+		if electionID == "" {
+			electionID = DEFAULT_ELECTION_ID
+		}
+
+		log.Printf("Received %s request for election %s from %s", req.Type, electionID, conn.RemoteAddr())
 
 		switch req.Type {
 		case pb.GenericRequest_LOGIN:
-			user := s.handleLogin(conn, req.Payload)
+			user := s.handleLogin(conn, req.RequestId, req.Payload)
 			if user != nil {
 				loggedInUser = user // Associate user with this connection handler
 				log.Printf("User %s (%s) logged in from %s", loggedInUser.ID, loggedInUser.UserType, conn.RemoteAddr())
 			}
+		case pb.GenericRequest_LIST_ELECTIONS:
+			if loggedInUser == nil {
+				s.sendErrorResponse(conn, req.RequestId, "", "Not logged in")
+				continue
+			}
+			s.handleListElections(conn, req.RequestId, loggedInUser)
 		case pb.GenericRequest_GET_CANDIDATES:
 			if loggedInUser == nil {
-				s.sendErrorResponse(conn, "Not logged in")
+				s.sendErrorResponse(conn, req.RequestId, "", "Not logged in")
 				continue
 			}
-			s.handleGetCandidates(conn)
+			s.handleGetCandidates(conn, req.RequestId, electionID, loggedInUser)
 		case pb.GenericRequest_SUBMIT_VOTE:
 			if loggedInUser == nil || loggedInUser.UserType != pb.UserType_ELECTOR {
-				s.sendErrorResponse(conn, "Only logged-in electors can vote")
+				s.sendErrorResponse(conn, req.RequestId, "", "Only logged-in electors can vote")
 				continue
 			}
-			s.handleSubmitVote(conn, req.Payload, loggedInUser) // Pass the loggedInUser
+			s.handleSubmitVote(conn, req.RequestId, electionID, req.Payload, loggedInUser) // Pass the loggedInUser
 		case pb.GenericRequest_ADD_CANDIDATE:
 			if loggedInUser == nil || loggedInUser.UserType != pb.UserType_ADMIN {
-				s.sendErrorResponse(conn, "Only logged-in admins can add candidates")
+				s.sendErrorResponse(conn, req.RequestId, "", "Only logged-in admins can add candidates")
 				continue
 			}
-			s.handleAddCandidate(conn, req.Payload)
+			s.handleAddCandidate(conn, req.RequestId, electionID, req.Payload)
 		case pb.GenericRequest_REMOVE_CANDIDATE:
 			if loggedInUser == nil || loggedInUser.UserType != pb.UserType_ADMIN {
-				s.sendErrorResponse(conn, "Only logged-in admins can remove candidates")
+				s.sendErrorResponse(conn, req.RequestId, "", "Only logged-in admins can remove candidates")
 				continue
 			}
-			s.handleRemoveCandidate(conn, req.Payload)
+			s.handleRemoveCandidate(conn, req.RequestId, electionID, req.Payload)
+		case pb.GenericRequest_CREATE_ELECTION, pb.GenericRequest_START_ELECTION, pb.GenericRequest_EXTEND_DEADLINE, pb.GenericRequest_CLOSE_NOW, pb.GenericRequest_PUBLISH_RESULTS:
+			if loggedInUser == nil || loggedInUser.UserType != pb.UserType_ADMIN {
+				s.sendErrorResponse(conn, req.RequestId, "", "Only logged-in admins can manage elections")
+				continue
+			}
+			switch req.Type {
+			case pb.GenericRequest_CREATE_ELECTION:
+				s.handleCreateElection(conn, req.RequestId, req.Payload)
+			case pb.GenericRequest_START_ELECTION:
+				s.handleStartElection(conn, req.RequestId, electionID, req.Payload)
+			case pb.GenericRequest_EXTEND_DEADLINE:
+				s.handleExtendDeadline(conn, req.RequestId, electionID, req.Payload)
+			case pb.GenericRequest_CLOSE_NOW:
+				s.handleCloseNow(conn, req.RequestId, electionID)
+			default:
+				s.handlePublishResults(conn, req.RequestId, electionID)
+			}
 		default:
 			log.Printf("Unknown request type from %s: %v", conn.RemoteAddr(), req.Type)
-			s.sendErrorResponse(conn, "Unknown request type")
+			s.sendErrorResponse(conn, req.RequestId, "", "Unknown request type")
 		}
 	}
 }
 
-func (s *Server) handleLogin(conn net.Conn, payload []byte) *User {
+func (s *Server) handleLogin(conn net.Conn, requestID uint64, payload []byte) *User {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	loginReq := &pb.LoginPayload{}
 	if err := proto.Unmarshal(payload, loginReq); err != nil {
-		s.sendErrorResponseLocked(conn, "Invalid login payload")
+		s.sendErrorResponseLocked(conn, requestID, "", "Invalid login payload")
 		return nil
 	}
 
 	user, exists := s.users[loginReq.UserId]
 	if !exists || user.Password != loginReq.Password || user.UserType != loginReq.UserType {
-		s.sendErrorResponseLocked(conn, "Invalid credentials or user type")
+		s.sendErrorResponseLocked(conn, requestID, "", "Invalid credentials or user type")
 		return nil
 	}
 
 	if user.Conn != nil && user.Conn != conn { // Allow re-login on same conn, but not if active elsewhere
-		s.sendErrorResponseLocked(conn, "User already logged in on another connection")
+		s.sendErrorResponseLocked(conn, requestID, "", "User already logged in on another connection")
 		return nil
 	}
 	user.Conn = conn
 
 	respType := pb.GenericResponse_LOGIN_SUCCESS_ELECTOR
-	var respPayloadData []byte
-	var err error
-	message := "Login successful."
-
-	if user.UserType == pb.UserType_ELECTOR {
-		if s.isVotingOpen {
-			candidatesList := make([]*pb.Candidate, 0, len(s.candidates))
-			for _, c := range s.candidates {
-				candidatesList = append(candidatesList, &pb.Candidate{Id: c.Id, Name: c.Name})
-			}
-			clp := &pb.CandidateListPayload{
-				Candidates:     candidatesList,
-				VotingDeadline: s.votingDeadline.Format(time.RFC3339),
-			}
-			respPayloadData, err = proto.Marshal(clp)
-			if err != nil {
-				s.sendErrorResponseLocked(conn, "Failed to prepare candidate list")
-				user.Conn = nil
-				return nil
-			}
-		} else {
-			message = "Login successful. Voting is not currently open."
-			if s.electionResults != nil {
-				message = "Login successful. Voting has ended."
-				respType = pb.GenericResponse_ELECTION_RESULTS // Send results instead if available
-				respPayloadData, _ = proto.Marshal(s.electionResults)
-			}
-		}
-	} else if user.UserType == pb.UserType_ADMIN {
+	if user.UserType == pb.UserType_ADMIN {
 		respType = pb.GenericResponse_LOGIN_SUCCESS_ADMIN
 	}
 
-	s.sendProtoResponse(conn, respType, respPayloadData, message, true)
+	// Both kinds of user get the elections they can take part in, to pick one from.
+	elp := s.electionListLocked(user)
+	respPayloadData, err := proto.Marshal(elp)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Failed to prepare election list")
+		user.Conn = nil
+		return nil
+	}
+	message := fmt.Sprintf("Login successful. %d election(s) available.", len(elp.Elections))
+
+	s.sendProtoResponseLocked(conn, requestID, "", respType, respPayloadData, message, true)
 	return user
 }
 
-func (s *Server) handleGetCandidates(conn net.Conn) {
+// electionListLocked lists every election for admins, and only those an elector is
+// eligible for otherwise.
+func (s *Server) electionListLocked(user *User) *pb.ElectionListPayload { // For use when s.mu is already locked
+	elp := &pb.ElectionListPayload{}
+	for _, e := range s.sortedElectionsLocked() {
+		if user.UserType == pb.UserType_ELECTOR && !e.isEligibleLocked(user.ID) {
+			continue
+		}
+		summary := &pb.ElectionSummary{ElectionId: e.ID, Title: e.Title, State: e.state}
+		if e.state != pb.ElectionState_DRAFT {
+			summary.VotingDeadline = e.votingDeadline.Format(time.RFC3339)
+		}
+		elp.Elections = append(elp.Elections, summary)
+	}
+	return elp
+}
+
+func (s *Server) handleListElections(conn net.Conn, requestID uint64, user *User) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if !s.isVotingOpen {
-		if s.electionResults != nil {
-			resultsPayloadBytes, err := proto.Marshal(s.electionResults)
-			if err != nil {
-				s.sendErrorResponseLocked(conn, "Failed to serialize results")
-				return
-			}
-			s.sendProtoResponse(conn, pb.GenericResponse_ELECTION_RESULTS, resultsPayloadBytes, "Voting has ended. Here are the results.", true)
-		} else {
-			s.sendProtoResponse(conn, pb.GenericResponse_GENERAL_STATUS, nil, "Voting is not currently open and no results available.", false)
+	elp := s.electionListLocked(user)
+	payloadBytes, err := proto.Marshal(elp)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Failed to prepare election list")
+		return
+	}
+	s.sendProtoResponseLocked(conn, requestID, "", pb.GenericResponse_ELECTION_LIST, payloadBytes, fmt.Sprintf("%d election(s) available.", len(elp.Elections)), true)
+}
+
+// stateStatusMessage tells electors why there is neither a ballot nor results to show.
+func stateStatusMessage(state pb.ElectionState) string {
+	if state == pb.ElectionState_CLOSED {
+		return "Voting has closed. Results have not been published yet."
+	}
+	return "The election has not started yet."
+}
+
+func (s *Server) handleGetCandidates(conn net.Conn, requestID uint64, electionID string, user *User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Cannot get candidates: "+err.Error()+".")
+		return
+	}
+	if user.UserType == pb.UserType_ELECTOR && !e.isEligibleLocked(user.ID) {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "You are not eligible to vote in this election.")
+		return
+	}
+
+	// Admins may see the results as soon as voting closes; electors once they are published.
+	showResults := e.state == pb.ElectionState_PUBLISHED || (e.state == pb.ElectionState_CLOSED && user.UserType == pb.UserType_ADMIN)
+	if showResults {
+		resultsPayloadBytes, err := proto.Marshal(e.electionResults)
+		if err != nil {
+			s.sendErrorResponseLocked(conn, requestID, e.ID, "Failed to serialize results")
+			return
 		}
+		s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_ELECTION_RESULTS, resultsPayloadBytes, "Voting has ended. Here are the results.", true)
+		return
+	}
+	if e.state != pb.ElectionState_OPEN {
+		s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_GENERAL_STATUS, nil, stateStatusMessage(e.state), false)
 		return
 	}
 
-	candidatesList := make([]*pb.Candidate, 0, len(s.candidates))
-	for _, c := range s.candidates {
+	candidatesList := make([]*pb.Candidate, 0, len(e.candidates))
+	for _, c := range e.candidates {
 		candidatesList = append(candidatesList, &pb.Candidate{Id: c.Id, Name: c.Name})
 	}
 	clp := &pb.CandidateListPayload{
 		Candidates:     candidatesList,
-		VotingDeadline: s.votingDeadline.Format(time.RFC3339),
+		VotingDeadline: e.votingDeadline.Format(time.RFC3339),
+		ElectionId:     e.ID,
 	}
 	payloadBytes, err := proto.Marshal(clp)
 	if err != nil {
-		s.sendErrorResponseLocked(conn, "Failed to prepare candidate list")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Failed to prepare candidate list")
 		return
 	}
-	s.sendProtoResponse(conn, pb.GenericResponse_CANDIDATE_LIST, payloadBytes, "Current candidates and deadline", true)
+	s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_CANDIDATE_LIST, payloadBytes, "Current candidates and deadline", true)
 }
 
-func (s *Server) handleSubmitVote(conn net.Conn, payload []byte, elector *User) {
+func (s *Server) handleSubmitVote(conn net.Conn, requestID uint64, electionID string, payload []byte, elector *User) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if !s.isVotingOpen {
-		s.sendErrorResponseLocked(conn, "Voting is closed.")
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Cannot vote: "+err.Error()+".")
+		return
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_SUBMIT_VOTE); err != nil {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Cannot vote: "+err.Error()+".")
 		return
 	}
-	if elector.HasVoted {
-		s.sendErrorResponseLocked(conn, "You have already voted.")
+	if !e.isEligibleLocked(elector.ID) {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "You are not eligible to vote in this election.")
+		return
+	}
+	if e.voted[elector.ID] {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "You have already voted.")
 		return
 	}
 
 	voteReq := &pb.SubmitVotePayload{}
 	if err := proto.Unmarshal(payload, voteReq); err != nil {
-		s.sendErrorResponseLocked(conn, "Invalid vote payload.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Invalid vote payload.")
 		return
 	}
 	if voteReq.ElectorId != elector.ID { // Sanity check
-		s.sendErrorResponseLocked(conn, "Vote payload elector ID mismatch.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Vote payload elector ID mismatch.")
 		return
 	}
 
-	candidate, exists := s.candidates[voteReq.CandidateId]
+	candidate, exists := e.candidates[voteReq.CandidateId]
 	if !exists {
-		s.sendErrorResponseLocked(conn, "Invalid candidate ID.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Invalid candidate ID.")
+		return
+	}
+
+	// The vote must be durable before it is counted and acknowledged.
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_VOTE, ElectionID: e.ID, ElectorID: elector.ID, CandidateID: candidate.Id}); err != nil {
+		log.Printf("Failed to journal vote from %s in election %s: %v", elector.ID, e.ID, err)
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Failed to record vote. Please try again.")
 		return
 	}
 
 	candidate.VoteCount++      // This is a pointer, updates the map's value.
-	s.votes[candidate.Id]++ // Also update the specific tally map.
-	elector.HasVoted = true
+	e.votes[candidate.Id]++ // Also update the specific tally map.
+	e.voted[elector.ID] = true
 
-	log.Printf("Elector %s voted for %s (%s)", elector.ID, candidate.Name, candidate.Id)
-	s.sendProtoResponse(conn, pb.GenericResponse_VOTE_ACK, nil, "Vote successfully recorded.", true)
+	log.Printf("Elector %s voted for %s (%s) in election %s", elector.ID, candidate.Name, candidate.Id, e.ID)
+	s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_VOTE_ACK, nil, "Vote successfully recorded.", true)
 }
 
-func (s *Server) handleAddCandidate(conn net.Conn, payload []byte) {
+func (s *Server) handleAddCandidate(conn net.Conn, requestID uint64, electionID string, payload []byte) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.isVotingOpen { // Stricter: disallow if voting has ever started for this session
-		s.sendErrorResponseLocked(conn, "Cannot add candidates while voting is open or has concluded.")
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Cannot add candidates: "+err.Error()+".")
+		return
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_ADD_CANDIDATE); err != nil {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Cannot add candidates: "+err.Error()+".")
 		return
 	}
 
 	addReq := &pb.AddCandidatePayload{}
 	if err := proto.Unmarshal(payload, addReq); err != nil {
-		s.sendErrorResponseLocked(conn, "Invalid add candidate payload.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Invalid add candidate payload.")
 		return
 	}
 	if addReq.Candidate == nil || addReq.Candidate.Id == "" || addReq.Candidate.Name == "" {
-		s.sendErrorResponseLocked(conn, "Candidate ID and Name cannot be empty.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Candidate ID and Name cannot be empty.")
 		return
 	}
-	if _, exists := s.candidates[addReq.Candidate.Id]; exists {
-		s.sendErrorResponseLocked(conn, "Candidate ID already exists.")
+	if _, exists := e.candidates[addReq.Candidate.Id]; exists {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Candidate ID already exists.")
 		return
 	}
 
-	newCand := &pb.Candidate{
-		Id:        addReq.Candidate.Id,
-		Name:      addReq.Candidate.Name,
-		VoteCount: 0,
+	newCand, err := s.addCandidateLocked(e, addReq.Candidate.Id, addReq.Candidate.Name)
+	if err != nil {
+		log.Printf("Failed to journal new candidate %s in election %s: %v", addReq.Candidate.Id, e.ID, err)
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Failed to record candidate.")
+		return
 	}
-	s.candidates[newCand.Id] = newCand
-	s.votes[newCand.Id] = 0 // Ensure it's in the tally map
 
-	log.Printf("Admin added candidate: %s (%s)", newCand.Name, newCand.Id)
-	s.sendProtoResponse(conn, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Candidate added successfully.", true)
+	log.Printf("Admin added candidate: %s (%s) to election %s", newCand.Name, newCand.Id, e.ID)
+	s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Candidate added successfully.", true)
 }
 
-func (s *Server) handleRemoveCandidate(conn net.Conn, payload []byte) {
+func (s *Server) handleRemoveCandidate(conn net.Conn, requestID uint64, electionID string, payload []byte) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.isVotingOpen {
-		s.sendErrorResponseLocked(conn, "Cannot remove candidates while voting is open or has concluded.")
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Cannot remove candidates: "+err.Error()+".")
+		return
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_REMOVE_CANDIDATE); err != nil {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Cannot remove candidates: "+err.Error()+".")
 		return
 	}
 
 	removeReq := &pb.RemoveCandidatePayload{}
 	if err := proto.Unmarshal(payload, removeReq); err != nil {
-		s.sendErrorResponseLocked(conn, "Invalid remove candidate payload.")
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Invalid remove candidate payload.")
 		return
 	}
-	if _, exists := s.candidates[removeReq.CandidateId]; !exists {
-		s.sendErrorResponseLocked(conn, "Candidate ID not found.")
+	if _, exists := e.candidates[removeReq.CandidateId]; !exists {
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Candidate ID not found.")
 		return
 	}
 
-	delete(s.candidates, removeReq.CandidateId)
-	delete(s.votes, removeReq.CandidateId)
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_CANDIDATE_REMOVED, ElectionID: e.ID, CandidateID: removeReq.CandidateId}); err != nil {
+		log.Printf("Failed to journal candidate removal %s in election %s: %v", removeReq.CandidateId, e.ID, err)
+		s.sendErrorResponseLocked(conn, requestID, e.ID, "Failed to record candidate removal.")
+		return
+	}
+	delete(e.candidates, removeReq.CandidateId)
+	delete(e.votes, removeReq.CandidateId)
 
-	log.Printf("Admin removed candidate ID: %s", removeReq.CandidateId)
-	s.sendProtoResponse(conn, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Candidate removed successfully.", true)
+	log.Printf("Admin removed candidate ID: %s from election %s", removeReq.CandidateId, e.ID)
+	s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Candidate removed successfully.", true)
 }
 
-func (s *Server) endVotingAndCalculateResults() {
+func (s *Server) handleCreateElection(conn net.Conn, requestID uint64, payload []byte) {
+	createReq := &pb.CreateElectionPayload{}
+	if err := proto.Unmarshal(payload, createReq); err != nil {
+		s.sendErrorResponse(conn, requestID, "", "Invalid create election payload.")
+		return
+	}
+
 	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, err := s.createElectionLocked(createReq.ElectionId, createReq.Title, createReq.EligibleElectorIds)
+	if err != nil {
+		s.sendErrorResponseLocked(conn, requestID, "", "Cannot create the election: "+err.Error()+".")
+		return
+	}
+	log.Printf("Admin created election %s (%s)", e.ID, e.Title)
+	s.sendProtoResponseLocked(conn, requestID, e.ID, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Election "+e.ID+" created.", true)
+}
+
+func (s *Server) handleStartElection(conn net.Conn, requestID uint64, electionID string, payload []byte) {
+	startReq := &pb.StartElectionPayload{}
+	if err := proto.Unmarshal(payload, startReq); err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Invalid start election payload.")
+		return
+	}
+	deadline := time.Now().Add(VOTING_DURATION)
+	if startReq.VotingDeadline != "" {
+		var err error
+		if deadline, err = time.Parse(time.RFC3339, startReq.VotingDeadline); err != nil {
+			s.sendErrorResponse(conn, requestID, electionID, "Invalid deadline, expected ISO 8601 (RFC 3339).")
+			return
+		}
+	}
+	if err := s.startVotingPeriod(electionID, deadline); err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Cannot start the election: "+err.Error()+".")
+		return
+	}
+	s.sendProtoResponse(conn, requestID, electionID, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Election started. Voting is open until "+deadline.Format(time.RFC3339)+".", true)
+}
+
+func (s *Server) handleExtendDeadline(conn net.Conn, requestID uint64, electionID string, payload []byte) {
+	extendReq := &pb.ExtendDeadlinePayload{}
+	if err := proto.Unmarshal(payload, extendReq); err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Invalid extend deadline payload.")
+		return
+	}
+	deadline, err := time.Parse(time.RFC3339, extendReq.NewDeadline)
+	if err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Invalid deadline, expected ISO 8601 (RFC 3339).")
+		return
+	}
+	if err := s.extendDeadline(electionID, deadline); err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Cannot extend the deadline: "+err.Error()+".")
+		return
+	}
+	s.sendProtoResponse(conn, requestID, electionID, pb.GenericResponse_ADMIN_ACTION_ACK, nil, "Deadline extended to "+deadline.Format(time.RFC3339)+".", true)
+}
+
+func (s *Server) handleCloseNow(conn net.Conn, requestID uint64, electionID string) {
+	results, err := s.endVotingAndCalculateResults(electionID, time.Time{}, conn)
+	if err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Cannot close voting: "+err.Error()+".")
+		return
+	}
+	resultsPayloadBytes, err := proto.Marshal(results)
+	if err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Voting closed, but the results could not be serialized.")
+		return
+	}
+	s.sendProtoResponse(conn, requestID, electionID, pb.GenericResponse_ELECTION_RESULTS, resultsPayloadBytes, "Voting closed by admin. Here are the results.", true)
+}
+
+func (s *Server) handlePublishResults(conn net.Conn, requestID uint64, electionID string) {
+	results, err := s.publishResults(electionID)
+	if err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Cannot publish results: "+err.Error()+".")
+		return
+	}
+	s.broadcastResults(electionID, results, pb.UserType_ELECTOR, conn, "Results of election "+electionID+" have been published.")
+	resultsPayloadBytes, err := proto.Marshal(results)
+	if err != nil {
+		s.sendErrorResponse(conn, requestID, electionID, "Results published, but they could not be serialized.")
+		return
+	}
+	s.sendProtoResponse(conn, requestID, electionID, pb.GenericResponse_ELECTION_RESULTS, resultsPayloadBytes, "Results published to electors.", true)
+}
+
+// publishResults makes the results of a closed election visible to electors.
+func (s *Server) publishResults(electionID string) (*pb.ElectionResultsPayload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.checkStateLocked(pb.GenericRequest_PUBLISH_RESULTS); err != nil {
+		return nil, err
+	}
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_RESULTS_PUBLISHED, ElectionID: e.ID}); err != nil {
+		log.Printf("Failed to journal results publication of election %s: %v", e.ID, err)
+		return nil, errors.New("failed to record the publication")
+	}
+	if err := e.transitionLocked(pb.ElectionState_PUBLISHED); err != nil {
+		return nil, err
+	}
+	log.Printf("Results of election %s published.", e.ID)
+	return e.electionResults, nil
+}
+
+func (s *Server) addCandidateLocked(e *Election, id, name string) (*pb.Candidate, error) {
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_CANDIDATE_ADDED, ElectionID: e.ID, CandidateID: id, CandidateName: name}); err != nil {
+		return nil, err
+	}
+	newCand := &pb.Candidate{
+		Id:        id,
+		Name:      name,
+		VoteCount: 0,
+	}
+	e.candidates[newCand.Id] = newCand
+	e.votes[newCand.Id] = 0 // Ensure it's in the tally map
+	return newCand, nil
+}
+
+// endVotingAndCalculateResults closes voting in an election and calculates the results.
+// The voting timer passes the deadline it was scheduled for, so a timer left behind by an
+// extended deadline does nothing; CLOSE_NOW passes the zero time to close immediately,
+// along with the admin's connection, which gets the results in its response.
+func (s *Server) endVotingAndCalculateResults(electionID string, scheduledFor time.Time, closedBy net.Conn) (*pb.ElectionResultsPayload, error) {
+	s.mu.Lock()
+	e, err := s.electionLocked(electionID)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
 	// Check if already ended by another path or called multiple times
-	if !s.isVotingOpen && s.electionResults != nil {
+	if err := e.checkStateLocked(pb.GenericRequest_CLOSE_NOW); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	if !scheduledFor.IsZero() && !scheduledFor.Equal(e.votingDeadline) {
+		s.mu.Unlock()
+		return nil, nil
+	}
+	e.transitionLocked(pb.ElectionState_CLOSED) // Cannot fail: the state was checked above
+	if e.votingTimer != nil {
+		e.votingTimer.Stop()
+		e.votingTimer = nil
+	}
+	if err := s.recordLocked(journalEntry{Type: JOURNAL_VOTING_ENDED, ElectionID: e.ID}); err != nil {
+		// The deadline is journaled too, so a restart will still close the election.
+		log.Printf("Failed to journal end of voting in election %s: %v", e.ID, err)
+	}
+	log.Printf("Voting in election %s has officially ended. Calculating results...", e.ID)
+
+	results := e.calculateResultsLocked()
+	e.electionResults = results
+	s.mu.Unlock() // Unlock before logging or broadcasting
+
+	log.Printf("Results Calculated for election %s: Total Votes: %d", electionID, results.TotalVotes)
+	if results.Winner != nil {
+		log.Printf("Winner: %s with %d votes (%.2f%%)", results.Winner.Name, results.Winner.VoteCount, results.Winner.Percentage)
+	} else {
+		log.Println("No winner determined or no votes cast.")
+	}
+	// Admins get the tally as soon as voting ends; electors when it is published.
+	s.broadcastResults(electionID, results, pb.UserType_ADMIN, closedBy, "Voting in election "+electionID+" has ended.")
+	return results, nil
+}
+
+// pushTarget is a connection picked for a broadcast, taken with s.mu held so the push
+// itself can be written without it.
+type pushTarget struct {
+	userID    string
+	conn      net.Conn
+	writeLock *sync.Mutex
+}
+
+// broadcastResults pushes results as an unsolicited ELECTION_RESULTS frame to every
+// logged-in user of userType allowed to see them, except skip. The pushes are written
+// in parallel and without s.mu, so a client that stops reading only delays its own push;
+// its connection is closed when the push fails, and handleConnection then forgets it.
+func (s *Server) broadcastResults(electionID string, results *pb.ElectionResultsPayload, userType pb.UserType, skip net.Conn, message string) {
+	payloadBytes, err := proto.Marshal(results)
+	if err != nil {
+		log.Printf("Failed to serialize results of election %s for broadcast: %v", electionID, err)
+		return
+	}
+
+	s.mu.Lock()
+	e, err := s.electionLocked(electionID)
+	if err != nil {
 		s.mu.Unlock()
 		return
 	}
-	s.isVotingOpen = false // Ensure voting is marked closed
-	log.Println("Voting has officially ended. Calculating results...")
+	push := &pb.GenericResponse{
+		Type:          pb.GenericResponse_ELECTION_RESULTS,
+		Payload:       payloadBytes,
+		Message:       message,
+		Success:       true,
+		ElectionId:    e.ID,
+		ElectionState: e.state,
+		Pushed:        true,
+	}
+	var targets []pushTarget
+	for _, user := range s.users {
+		if user.Conn == nil || user.Conn == skip || user.UserType != userType {
+			continue
+		}
+		if user.UserType == pb.UserType_ELECTOR && !e.isEligibleLocked(user.ID) {
+			continue
+		}
+		if writeLock, open := s.writeLocks[user.Conn]; open {
+			targets = append(targets, pushTarget{userID: user.ID, conn: user.Conn, writeLock: writeLock})
+		}
+	}
+	s.mu.Unlock()
+
+	var pushed atomic.Int32
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target pushTarget) {
+			defer wg.Done()
+			target.writeLock.Lock()
+			target.conn.SetWriteDeadline(time.Now().Add(PUSH_WRITE_TIMEOUT))
+			err := sendProtoMessage(target.conn, push)
+			target.conn.SetWriteDeadline(time.Time{})
+			target.writeLock.Unlock()
+			if err != nil {
+				// A partial frame may have been written, so the connection cannot be reused.
+				log.Printf("Failed to push results of election %s to %s, closing its connection: %v", electionID, target.userID, err)
+				target.conn.Close()
+				return
+			}
+			pushed.Add(1)
+		}(target)
+	}
+	wg.Wait()
+	log.Printf("Pushed results of election %s to %d connected %s(s)", electionID, pushed.Load(), strings.ToLower(userType.String()))
+}
 
+func (e *Election) calculateResultsLocked() *pb.ElectionResultsPayload { // For use when s.mu is already locked
 	totalVotes := int32(0)
-	// Use s.votes for final tally as s.candidates[id].VoteCount might not be consistently updated if logic error
-	for _, count := range s.votes {
+	// Use e.votes for final tally as e.candidates[id].VoteCount might not be consistently updated if logic error
+	for _, count := range e.votes {
 		totalVotes += count
 	}
-	
-	// Update candidate objects with final counts from s.votes
-	for id, cand := range s.candidates {
-		cand.VoteCount = s.votes[id] // Ensure candidate object has the correct final count
+
+	// Update candidate objects with final counts from e.votes
+	for id, cand := range e.candidates {
+		cand.VoteCount = e.votes[id] // Ensure candidate object has the correct final count
 	}
 
 	results := &pb.ElectionResultsPayload{
 		TotalVotes:       totalVotes,
-		CandidateResults: make([]*pb.Candidate, 0, len(s.candidates)),
+		CandidateResults: make([]*pb.Candidate, 0, len(e.candidates)),
 		StatusMessage:    "Voting has ended. Final Results:",
+		ElectionId:       e.ID,
 	}
 
 	maxVotes := int32(-1)
 	var winner *pb.Candidate = nil // Initialize winner as nil
 
-	for _, cand := range s.candidates { // Iterate over the s.candidates map which has full Candidate objects
+	for _, cand := range e.candidates { // Iterate over the e.candidates map which has full Candidate objects
 		percentage := 0.0
 		if totalVotes > 0 {
 			percentage = (float64(cand.VoteCount) / float64(totalVotes)) * 100.0
 		}
-		cand.Percentage = percentage // Update percentage in the server's candidate map instance
+		cand.Percentage = percentage // Update percentage in the election's candidate map instance
 
 		resultCand := &pb.Candidate{ // Create a copy for the results payload
 			Id:         cand.Id,
@@ -431,7 +1004,7 @@ func (s *Server) endVotingAndCalculateResults() {
 			}
 		}
 	}
-	
+
 	if totalVotes == 0 {
 		results.StatusMessage = "Voting has ended. No votes were cast."
 		results.Winner = &pb.Candidate{Name: "No winner (no votes)"}
@@ -440,17 +1013,7 @@ func (s *Server) endVotingAndCalculateResults() {
 	} else {
 		results.Winner = winner
 	}
-
-	s.electionResults = results
-	s.mu.Unlock() // Unlock before logging or broadcasting
-
-	log.Printf("Results Calculated: Total Votes: %d", results.TotalVotes)
-	if results.Winner != nil {
-		log.Printf("Winner: %s with %d votes (%.2f%%)", results.Winner.Name, results.Winner.VoteCount, results.Winner.Percentage)
-	} else {
-		log.Println("No winner determined or no votes cast.")
-	}
-	// Optionally, broadcast results to all connected clients.
+	return results
 }
 
 func sendProtoMessage(conn net.Conn, msg proto.Message) error {
@@ -467,34 +1030,62 @@ func sendProtoMessage(conn net.Conn, msg proto.Message) error {
 	return nil
 }
 
-func (s *Server) sendProtoResponse(conn net.Conn, respType pb.GenericResponse_Type, payloadData []byte, message string, success bool) {
+func (s *Server) sendProtoResponse(conn net.Conn, requestID uint64, electionID string, respType pb.GenericResponse_Type, payloadData []byte, message string, success bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sendProtoResponseLocked(conn, requestID, electionID, respType, payloadData, message, success)
+}
+
+// sendProtoResponseLocked reports the ID and current state of electionID along with the
+// response; pass "" for responses that are not about a single election. requestID is the
+// ID of the request being answered, or 0 when it could not be read.
+func (s *Server) sendProtoResponseLocked(conn net.Conn, requestID uint64, electionID string, respType pb.GenericResponse_Type, payloadData []byte, message string, success bool) { // For use when s.mu is already locked
 	resp := &pb.GenericResponse{
-		Type:    respType,
-		Payload: payloadData,
-		Message: message,
-		Success: success,
+		Type:      respType,
+		Payload:   payloadData,
+		Message:   message,
+		Success:   success,
+		RequestId: requestID,
+	}
+	if e, exists := s.elections[electionID]; exists {
+		resp.ElectionId = e.ID
+		resp.ElectionState = e.state
+	}
+	if writeLock, open := s.writeLocks[conn]; open {
+		writeLock.Lock()
+		defer writeLock.Unlock()
 	}
 	if err := sendProtoMessage(conn, resp); err != nil {
 		log.Printf("Error sending %s response to %s: %v", respType, conn.RemoteAddr(), err)
 	}
 }
 
-func (s *Server) sendErrorResponse(conn net.Conn, message string) {
-	s.sendProtoResponse(conn, pb.GenericResponse_GENERAL_STATUS, nil, message, false)
+func (s *Server) sendErrorResponse(conn net.Conn, requestID uint64, electionID string, message string) {
+	s.sendProtoResponse(conn, requestID, electionID, pb.GenericResponse_GENERAL_STATUS, nil, message, false)
 }
 
-func (s *Server) sendErrorResponseLocked(conn net.Conn, message string) { // For use when s.mu is already locked
-	s.sendProtoResponse(conn, pb.GenericResponse_GENERAL_STATUS, nil, message, false)
+func (s *Server) sendErrorResponseLocked(conn net.Conn, requestID uint64, electionID string, message string) { // For use when s.mu is already locked
+	s.sendProtoResponseLocked(conn, requestID, electionID, pb.GenericResponse_GENERAL_STATUS, nil, message, false)
 }
 
 func main() {
 	server := NewServer()
-	// Example: Pre-add some candidates before starting
+	if err := server.OpenJournal(JOURNAL_PATH); err != nil {
+		log.Fatalf("Failed to recover election state: %v", err)
+	}
+	// Example: Create the default election with some candidates on a fresh journal
 	server.mu.Lock()
-	server.candidates["c1"] = &pb.Candidate{Id: "c1", Name: "Candidate Alpha", VoteCount: 0}
-	server.candidates["c2"] = &pb.Candidate{Id: "c2", Name: "Candidate Beta", VoteCount: 0}
-	server.votes["c1"] = 0
-	server.votes["c2"] = 0
+	if len(server.elections) == 0 {
+		election, err := server.createElectionLocked(DEFAULT_ELECTION_ID, DEFAULT_ELECTION_TITLE, nil)
+		if err != nil {
+			log.Fatalf("Failed to journal the default election: %v", err)
+		}
+		for _, c := range []*pb.Candidate{{Id: "c1", Name: "Candidate Alpha"}, {Id: "c2", Name: "Candidate Beta"}} {
+			if _, err := server.addCandidateLocked(election, c.Id, c.Name); err != nil {
+				log.Fatalf("Failed to journal initial candidate %s: %v", c.Id, err)
+			}
+		}
+	}
 	server.mu.Unlock()
 
 	server.Start()
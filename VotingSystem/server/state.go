@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+
+	pb "voting_system/proto"
+)
+
+// allowedStates lists the states in which each request type is accepted by the election
+// it addresses. Request types missing from the table (GET_CANDIDATES, and those that are
+// not about a single election) are accepted in every state.
+var allowedStates = map[pb.GenericRequest_Type][]pb.ElectionState{
+	pb.GenericRequest_SUBMIT_VOTE:      {pb.ElectionState_OPEN},
+	pb.GenericRequest_ADD_CANDIDATE:    {pb.ElectionState_DRAFT},
+	pb.GenericRequest_REMOVE_CANDIDATE: {pb.ElectionState_DRAFT},
+	pb.GenericRequest_START_ELECTION:   {pb.ElectionState_DRAFT},
+	pb.GenericRequest_EXTEND_DEADLINE:  {pb.ElectionState_OPEN},
+	pb.GenericRequest_CLOSE_NOW:        {pb.ElectionState_OPEN},
+	pb.GenericRequest_PUBLISH_RESULTS:  {pb.ElectionState_CLOSED},
+}
+
+// nextState is the only transition out of each state: DRAFT -> OPEN -> CLOSED -> PUBLISHED.
+var nextState = map[pb.ElectionState]pb.ElectionState{
+	pb.ElectionState_DRAFT:  pb.ElectionState_OPEN,
+	pb.ElectionState_OPEN:   pb.ElectionState_CLOSED,
+	pb.ElectionState_CLOSED: pb.ElectionState_PUBLISHED,
+}
+
+// checkStateLocked returns an error when reqType is not accepted in the current state.
+func (e *Election) checkStateLocked(reqType pb.GenericRequest_Type) error { // For use when s.mu is already locked
+	allowed, restricted := allowedStates[reqType]
+	if !restricted {
+		return nil
+	}
+	for _, state := range allowed {
+		if e.state == state {
+			return nil
+		}
+	}
+	return fmt.Errorf("the election is %s", e.state)
+}
+
+func (e *Election) transitionLocked(to pb.ElectionState) error { // For use when s.mu is already locked
+	if next, ok := nextState[e.state]; !ok || next != to {
+		return fmt.Errorf("invalid election state transition %s -> %s", e.state, to)
+	}
+	e.state = to
+	return nil
+}
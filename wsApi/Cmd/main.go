@@ -5,25 +5,36 @@ import (
 	"net/http"
 
 	"sgrh/internal/handlers" 
-	"sgrh/internal/models"   
+	"sgrh/internal/store"   
 )
 
 func main() {
 	
-	db := make(map[string]*models.Departamento)
+	db := store.NewDepartamentoStore()
 
 	
-	db["TI"] = &models.Departamento{Nome: "TI"}
-	db["RH"] = &models.Departamento{Nome: "RH"}
+	db.Criar("TI")
+	db.Criar("RH")
 
 	
 	handler := handlers.NewDepartamentoHandler(db)
 
 
+	http.HandleFunc("GET /departamentos", handler.ListarDepartamentos)
+	http.HandleFunc("POST /departamentos", handler.CriarDepartamento)
+	http.HandleFunc("PATCH /departamentos/{nome}", handler.RenomearDepartamento)
+	http.HandleFunc("DELETE /departamentos/{nome}", handler.RemoverDepartamento)
+
 	http.HandleFunc("GET /departamentos/{nome}/colaboradores", handler.ListarColab)
 	http.HandleFunc("POST /departamentos/{nome}/colaboradores", handler.AddColaborador)
+	http.HandleFunc("GET /departamentos/{nome}/colaboradores/{id}", handler.ObterColaborador)
+	http.HandleFunc("PATCH /departamentos/{nome}/colaboradores/{id}", handler.AtualizarColaborador)
 	http.HandleFunc("DELETE /departamentos/{nome}/colaboradores/{id}", handler.DemitirColaborador)
+	http.HandleFunc("GET /departamentos/{nome}/colaboradores/{id}/holerite", handler.ObterHolerite)
 	http.HandleFunc("GET /departamentos/{nome}/folha-salarial", handler.CalcularFolhaSalarial)
+	http.HandleFunc("GET /departamentos/{nome}/folhas", handler.ListarFolhas)
+	http.HandleFunc("POST /departamentos/{nome}/folhas", handler.FecharFolha)
+	http.HandleFunc("GET /departamentos/{nome}/folhas/{competencia}", handler.ObterFolha)
 
 	log.Println("Servidor iniciado na porta :8080")
 	
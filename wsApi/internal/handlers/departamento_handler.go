@@ -2,110 +2,155 @@ package handlers
 
 import(
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sgrh/internal/models"
+	"sgrh/internal/store"
 	"net/http"
 	"strconv"
+	"strings"
 	"log"
 )
 
 type DepartamentoHandler struct{
-	DB map[string]*models.Departamento
+	Store     *store.DepartamentoStore
+	Descontos *models.MotorDescontos
 }
 
-func NewDepartamentoHandler(db map[string]*models.Departamento) *DepartamentoHandler{
-	return &DepartamentoHandler{DB: db}
+func NewDepartamentoHandler(s *store.DepartamentoStore) *DepartamentoHandler{
+	return &DepartamentoHandler{Store: s, Descontos: models.NewMotorDescontos(models.TabelasVigentes())}
+}
+
+// statusDoErro traduz os erros do store para o código HTTP correspondente.
+func statusDoErro(err error) int {
+	switch {
+	case errors.Is(err, store.ErrDepartamentoNaoEncontrado), errors.Is(err, models.ErrColaboradorNaoEncontrado),
+		errors.Is(err, store.ErrFolhaNaoEncontrada):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrAtualizacaoInvalida), errors.Is(err, models.ErrCompetenciaInvalida):
+		return http.StatusBadRequest
+	case errors.Is(err, store.ErrDepartamentoJaExiste), errors.Is(err, store.ErrDepartamentoComColaboradores),
+		errors.Is(err, models.ErrIDEmUso), errors.Is(err, store.ErrFolhaJaFechada):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 func(h *DepartamentoHandler) AddColaborador(w http.ResponseWriter, r *http.Request){
 	nomeDpto := r.PathValue("nome")
-	depto, ok := h.DB[nomeDpto]
 
-	if !ok {
-		http.Error(w, "Departamento nao encontrado", http.StatusNotFound)
+	var requestBody models.ColaboradorDTO
+
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "JSON inválido", http.StatusBadRequest)
 		return
 	}
 
-	var requestBody struct {
-		Tipo             string  `json:"tipo"` // "efetivo", "autonomo", "estagiario"
-		Id               int     `json:"id"`
-		Nome             string  `json:"nome"`
-		SalarioMensal    float64 `json:"salario_mensal,omitempty"`
-		HorasTrabalhadas int     `json:"horas_trabalhadas,omitempty"`
-		ValorHora        float64 `json:"valor_hora,omitempty"`
-		AuxilioEstagio   float64 `json:"auxilio_estagio,omitempty"`
+	novoColaborador, err := requestBody.ParaColaborador()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
-		http.Error(w, "JSON inválido", http.StatusBadRequest)
+	novoColaborador, err = h.Store.AdicionarColaborador(nomeDpto, novoColaborador)
+	if err != nil {
+		http.Error(w, err.Error(), statusDoErro(err))
 		return
 	}
+	log.Printf("Colaborador %s adicionado ao depto %s", novoColaborador.GetNome(), nomeDpto)
 
-	var novoColaborador models.Colaborador
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(models.NovoColaboradorDTO(novoColaborador))
+}
 
-	switch requestBody.Tipo {
-	case "efetivo":
-		novoColaborador = &models.Efetivo{
-			ColaboradorBase: models.ColaboradorBase{Id: requestBody.Id, Nome: requestBody.Nome},
-			SalarioMensal:   requestBody.SalarioMensal,
-		}
-	case "autonomo":
-		novoColaborador = &models.Autonomo{
-			ColaboradorBase:  models.ColaboradorBase{Id: requestBody.Id, Nome: requestBody.Nome},
-			HorasTrabalhadas: requestBody.HorasTrabalhadas,
-			ValorHora:        requestBody.ValorHora,
-		}
-	case "estagiario":
-		novoColaborador = &models.Estagiario{
-			ColaboradorBase: models.ColaboradorBase{Id: requestBody.Id, Nome: requestBody.Nome},
-			AuxilioEstagio:  requestBody.AuxilioEstagio,
+func (h *DepartamentoHandler) ObterColaborador(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+	depto, err := h.Store.Obter(nomeDepto)
+	if err != nil {
+		http.Error(w, "Departamento não encontrado", statusDoErro(err))
+		return
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "ID inválido", http.StatusBadRequest)
+		return
+	}
+
+	for _, c := range depto.Colaboradores {
+		if c.GetId() == id {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(models.NovoColaboradorDTO(c))
+			return
 		}
-	default:
-		http.Error(w, "Tipo de colaborador inválido", http.StatusBadRequest)
+	}
+	http.Error(w, "Colaborador não encontrado", http.StatusNotFound)
+}
+
+func (h *DepartamentoHandler) AtualizarColaborador(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "ID inválido", http.StatusBadRequest)
 		return
 	}
 
-	depto.AdicionarColaborador(novoColaborador)
-	log.Printf("Colaborador %s adicionado ao depto %s", novoColaborador.GetNome(), depto.Nome)
+	var requestBody models.AtualizacaoColaborador
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields() // "tipo" e "id" não são alteráveis
+	if err := decoder.Decode(&requestBody); err != nil {
+		http.Error(w, "JSON inválido", http.StatusBadRequest)
+		return
+	}
 
-	w.WriteHeader(http.StatusCreated)
-	fmt.Fprintf(w, "Colaborador adicionado com sucesso!")
+	colaborador, err := h.Store.AtualizarColaborador(nomeDepto, id, requestBody)
+	if err != nil {
+		http.Error(w, err.Error(), statusDoErro(err))
+		return
+	}
+	log.Printf("Colaborador ID %d atualizado no depto %s", id, nomeDepto)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(models.NovoColaboradorDTO(colaborador))
 }
 
 func (h *DepartamentoHandler) ListarColab (w http.ResponseWriter, r *http.Request){
 	nomeDpto := r.PathValue("nome")
-	depto, ok := h.DB[nomeDpto]
+	depto, err := h.Store.Obter(nomeDpto)
 
-	if !ok {
-		http.Error(w, "Departamento nao encontrado", http.StatusNotFound)
+	if err != nil {
+		http.Error(w, "Departamento nao encontrado", statusDoErro(err))
+		return
+	}
+
+	params, err := lerParametrosListagem(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	w.Header().Set("Content-type", "application/json")
-	json.NewEncoder(w).Encode(depto.Colaboradores)
+	json.NewEncoder(w).Encode(paginar(depto.Colaboradores, params))
 }
 
 func (h *DepartamentoHandler) DemitirColaborador(w http.ResponseWriter, r *http.Request) {
 	nomeDepto := r.PathValue("nome")
 	idStr := r.PathValue("id")
 
-	depto, ok := h.DB[nomeDepto]
-	if !ok {
-		http.Error(w, "Departamento não encontrado", http.StatusNotFound)
-		return
-	}
-
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
 		http.Error(w, "ID inválido", http.StatusBadRequest)
 		return
 	}
 
-	if err := depto.DemitirColaborador(id); err != nil {
+	if err := h.Store.DemitirColaborador(nomeDepto, id); err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
-	log.Printf("Colaborador ID %d demitido do depto %s", id, depto.Nome)
+	log.Printf("Colaborador ID %d demitido do depto %s", id, nomeDepto)
 
 	w.WriteHeader(http.StatusOK)
 	fmt.Fprintf(w, "Colaborador demitido com sucesso!")
@@ -113,19 +158,126 @@ func (h *DepartamentoHandler) DemitirColaborador(w http.ResponseWriter, r *http.
 
 func (h *DepartamentoHandler) CalcularFolhaSalarial(w http.ResponseWriter, r *http.Request) {
 	nomeDepto := r.PathValue("nome")
-	depto, ok := h.DB[nomeDepto]
-	if !ok {
-		http.Error(w, "Departamento não encontrado", http.StatusNotFound)
+	depto, err := h.Store.Obter(nomeDepto)
+	if err != nil {
+		http.Error(w, "Departamento não encontrado", statusDoErro(err))
 		return
 	}
 
 	total := depto.CalcularFolhaSalarial()
+	resumo := depto.ResumirFolha(h.Descontos)
+	if r.URL.Query().Get("detalhar") != "true" {
+		resumo.Holerites = nil
+	}
 
 	response := map[string]interface{}{
 		"departamento":           depto.Nome,
 		"total_folha_salarial": total,
+		"total_bruto":          resumo.TotalBruto,
+		"total_descontos":      resumo.TotalDescontos,
+		"total_liquido":        resumo.TotalLiquido,
+		"custo_empregador":     resumo.CustoEmpregador,
+	}
+	if resumo.Holerites != nil {
+		response["holerites"] = resumo.Holerites
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
-}
\ No newline at end of file
+}
+
+type departamentoResumo struct {
+	Nome               string `json:"nome"`
+	TotalColaboradores int    `json:"total_colaboradores"`
+}
+
+func (h *DepartamentoHandler) ListarDepartamentos(w http.ResponseWriter, r *http.Request) {
+	snapshots := h.Store.Listar()
+	deptos := make([]departamentoResumo, 0, len(snapshots))
+	for _, depto := range snapshots {
+		deptos = append(deptos, departamentoResumo{Nome: depto.Nome, TotalColaboradores: len(depto.Colaboradores)})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(deptos)
+}
+
+func (h *DepartamentoHandler) CriarDepartamento(w http.ResponseWriter, r *http.Request) {
+	var requestBody struct {
+		Nome string `json:"nome"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "JSON inválido", http.StatusBadRequest)
+		return
+	}
+
+	nome := strings.TrimSpace(requestBody.Nome)
+	if nome == "" {
+		http.Error(w, "Nome do departamento é obrigatório", http.StatusBadRequest)
+		return
+	}
+	if err := h.Store.Criar(nome); err != nil {
+		http.Error(w, "Departamento já existe", statusDoErro(err))
+		return
+	}
+	log.Printf("Departamento %s criado", nome)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(departamentoResumo{Nome: nome})
+}
+
+func (h *DepartamentoHandler) RenomearDepartamento(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+
+	var requestBody struct {
+		Nome string `json:"nome"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "JSON inválido", http.StatusBadRequest)
+		return
+	}
+
+	novoNome := strings.TrimSpace(requestBody.Nome)
+	if novoNome == "" {
+		http.Error(w, "Nome do departamento é obrigatório", http.StatusBadRequest)
+		return
+	}
+	if err := h.Store.Renomear(nomeDepto, novoNome); err != nil {
+		msg := "Departamento não encontrado"
+		if errors.Is(err, store.ErrDepartamentoJaExiste) {
+			msg = "Já existe um departamento com esse nome"
+		}
+		http.Error(w, msg, statusDoErro(err))
+		return
+	}
+	log.Printf("Departamento %s renomeado para %s", nomeDepto, novoNome)
+
+	depto, err := h.Store.Obter(novoNome)
+	if err != nil {
+		http.Error(w, "Departamento não encontrado", statusDoErro(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(departamentoResumo{Nome: depto.Nome, TotalColaboradores: len(depto.Colaboradores)})
+}
+
+func (h *DepartamentoHandler) RemoverDepartamento(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+
+	total, err := h.Store.Remover(nomeDepto, r.URL.Query().Get("force") == "true")
+	if errors.Is(err, store.ErrDepartamentoComColaboradores) {
+		msg := fmt.Sprintf("Departamento possui %d colaborador(es); use ?force=true para remover mesmo assim", total)
+		http.Error(w, msg, statusDoErro(err))
+		return
+	}
+	if err != nil {
+		http.Error(w, "Departamento não encontrado", statusDoErro(err))
+		return
+	}
+	log.Printf("Departamento %s removido (%d colaborador(es) desligados)", nomeDepto, total)
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "Departamento removido com sucesso!")
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sgrh/internal/models"
+	"sgrh/internal/store"
+	"strconv"
+	"time"
+)
+
+// FecharFolha congela a folha do departamento. Sem "competencia" no corpo, usa o mês atual.
+func (h *DepartamentoHandler) FecharFolha(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+
+	var requestBody struct {
+		Competencia string `json:"competencia"`
+	}
+	// Corpo vazio equivale a {}.
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "JSON inválido", http.StatusBadRequest)
+		return
+	}
+	if requestBody.Competencia == "" {
+		requestBody.Competencia = time.Now().Format(models.FormatoCompetencia)
+	}
+
+	folha, err := h.Store.FecharFolha(nomeDepto, requestBody.Competencia)
+	if err != nil {
+		http.Error(w, err.Error(), statusDoErro(err))
+		return
+	}
+	log.Printf("Folha %s do depto %s fechada: %.2f", folha.Competencia, nomeDepto, folha.Total)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(folha)
+}
+
+func (h *DepartamentoHandler) ListarFolhas(w http.ResponseWriter, r *http.Request) {
+	folhas, err := h.Store.ListarFolhas(r.PathValue("nome"))
+	if err != nil {
+		http.Error(w, "Departamento não encontrado", statusDoErro(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(folhas)
+}
+
+func (h *DepartamentoHandler) ObterFolha(w http.ResponseWriter, r *http.Request) {
+	folha, err := h.Store.ObterFolha(r.PathValue("nome"), r.PathValue("competencia"))
+	if err != nil {
+		http.Error(w, err.Error(), statusDoErro(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(folha)
+}
+
+// ObterHolerite emite o holerite do colaborador para o mês (?mes=AAAA-MM, padrão: mês atual)
+// em ?formato=texto|csv|json (padrão: json). Se a folha do mês já foi fechada, o holerite
+// sai dos valores congelados nela, para que a segunda via bata com o fechamento mesmo que
+// o colaborador tenha sido alterado, transferido ou demitido depois. Os dados atuais do
+// colaborador só são usados no mês corrente; um mês passado ou futuro sem folha fechada
+// devolve 404.
+func (h *DepartamentoHandler) ObterHolerite(w http.ResponseWriter, r *http.Request) {
+	nomeDepto := r.PathValue("nome")
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "ID inválido", http.StatusBadRequest)
+		return
+	}
+
+	mesAtual := time.Now().Format(models.FormatoCompetencia)
+	mes := r.URL.Query().Get("mes")
+	if mes == "" {
+		mes = mesAtual
+	}
+	if _, err := time.Parse(models.FormatoCompetencia, mes); err != nil {
+		http.Error(w, models.ErrCompetenciaInvalida.Error(), http.StatusBadRequest)
+		return
+	}
+	formato := r.URL.Query().Get("formato")
+	if formato == "" {
+		formato = models.FormatoJSON
+	}
+
+	var holerite models.Holerite
+	folha, err := h.Store.ObterFolha(nomeDepto, mes)
+	switch {
+	case err == nil:
+		item, ok := itemDaFolha(folha, id)
+		if !ok {
+			http.Error(w, "Colaborador não consta na folha fechada de "+mes, http.StatusNotFound)
+			return
+		}
+		holerite = h.Descontos.HoleriteDaFolha(item)
+	case errors.Is(err, store.ErrFolhaNaoEncontrada):
+		if mes != mesAtual {
+			http.Error(w, "A folha de "+mes+" não foi fechada", http.StatusNotFound)
+			return
+		}
+		colaborador, err := h.colaboradorAtual(nomeDepto, id)
+		if err != nil {
+			http.Error(w, err.Error(), statusDoErro(err))
+			return
+		}
+		holerite = h.Descontos.Holerite(colaborador)
+	default:
+		http.Error(w, err.Error(), statusDoErro(err))
+		return
+	}
+	holerite.Competencia = mes
+
+	var buf bytes.Buffer
+	if err := models.EscreverHolerite(&buf, holerite, formato); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", models.ContentTypeHolerite(formato))
+	if formato == models.FormatoCSV {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"holerite-%d-%s.csv\"", id, mes))
+	}
+	w.Write(buf.Bytes())
+}
+
+// colaboradorAtual busca o colaborador no estado atual do departamento.
+func (h *DepartamentoHandler) colaboradorAtual(nomeDepto string, id int) (models.Colaborador, error) {
+	depto, err := h.Store.Obter(nomeDepto)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range depto.Colaboradores {
+		if c.GetId() == id {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: ID %d", models.ErrColaboradorNaoEncontrado, id)
+}
+
+func itemDaFolha(folha models.FolhaPagamento, id int) (models.ItemFolha, bool) {
+	for _, item := range folha.Itens {
+		if item.ColaboradorID == id {
+			return item, true
+		}
+	}
+	return models.ItemFolha{}, false
+}
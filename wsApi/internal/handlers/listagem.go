@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sgrh/internal/models"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	limitePadrao = 50
+	limiteMaximo = 500
+)
+
+type parametrosListagem struct {
+	limite int
+	offset int
+	ordem  string
+	tipo   string
+}
+
+type paginaColaboradores struct {
+	Colaboradores []models.ColaboradorDTO `json:"colaboradores"`
+	Total         int                     `json:"total"`
+	ProximoCursor string                  `json:"proximo_cursor,omitempty"`
+}
+
+func lerParametrosListagem(q url.Values) (parametrosListagem, error) {
+	p := parametrosListagem{limite: limitePadrao}
+
+	if v := q.Get("limit"); v != "" {
+		limite, err := strconv.Atoi(v)
+		if err != nil || limite <= 0 {
+			return p, fmt.Errorf("limit inválido: %q", v)
+		}
+		p.limite = min(limite, limiteMaximo)
+	}
+
+	if v := q.Get("cursor"); v != "" {
+		offset, err := decodificarCursor(v)
+		if err != nil {
+			return p, err
+		}
+		p.offset = offset
+	}
+
+	switch v := q.Get("sort"); v {
+	case "", "id", "nome", "salario":
+		p.ordem = v
+	default:
+		return p, fmt.Errorf("sort inválido: %q (use nome, id ou salario)", v)
+	}
+
+	switch v := q.Get("tipo"); v {
+	case "", models.TipoEfetivo, models.TipoAutonomo, models.TipoEstagiario:
+		p.tipo = v
+	default:
+		return p, fmt.Errorf("tipo inválido: %q (use efetivo, autonomo ou estagiario)", v)
+	}
+
+	return p, nil
+}
+
+// paginar filtra, ordena e recorta uma cópia dos colaboradores; o slice original não é alterado.
+func paginar(colaboradores []models.Colaborador, p parametrosListagem) paginaColaboradores {
+	filtrados := make([]models.Colaborador, 0, len(colaboradores))
+	for _, c := range colaboradores {
+		if p.tipo == "" || models.TipoDe(c) == p.tipo {
+			filtrados = append(filtrados, c)
+		}
+	}
+
+	switch p.ordem {
+	case "id":
+		sort.SliceStable(filtrados, func(i, j int) bool { return filtrados[i].GetId() < filtrados[j].GetId() })
+	case "nome":
+		sort.SliceStable(filtrados, func(i, j int) bool {
+			return strings.ToLower(filtrados[i].GetNome()) < strings.ToLower(filtrados[j].GetNome())
+		})
+	case "salario":
+		sort.SliceStable(filtrados, func(i, j int) bool { return filtrados[i].CalcularSalario() < filtrados[j].CalcularSalario() })
+	}
+
+	pagina := paginaColaboradores{Total: len(filtrados), Colaboradores: []models.ColaboradorDTO{}}
+	if p.offset >= len(filtrados) {
+		return pagina
+	}
+	fim := min(p.offset+p.limite, len(filtrados))
+	pagina.Colaboradores = models.NovosColaboradoresDTO(filtrados[p.offset:fim])
+	if fim < len(filtrados) {
+		pagina.ProximoCursor = codificarCursor(fim)
+	}
+	return pagina
+}
+
+// O cursor é opaco para o cliente: hoje carrega apenas a posição na lista ordenada.
+func codificarCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodificarCursor(cursor string) (int, error) {
+	bruto, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("cursor inválido")
+	}
+	valor, ok := strings.CutPrefix(string(bruto), "offset:")
+	if !ok {
+		return 0, fmt.Errorf("cursor inválido")
+	}
+	offset, err := strconv.Atoi(valor)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("cursor inválido")
+	}
+	return offset, nil
+}
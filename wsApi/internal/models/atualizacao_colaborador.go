@@ -0,0 +1,62 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrColaboradorNaoEncontrado = errors.New("colaborador não encontrado")
+	ErrAtualizacaoInvalida      = errors.New("atualização inválida para o tipo do colaborador")
+)
+
+// AtualizacaoColaborador é o corpo de um PATCH: campos ausentes mantêm o valor atual.
+// Tipo e ID não podem ser alterados.
+type AtualizacaoColaborador struct {
+	Nome             *string  `json:"nome,omitempty"`
+	SalarioMensal    *float64 `json:"salario_mensal,omitempty"`
+	HorasTrabalhadas *int     `json:"horas_trabalhadas,omitempty"`
+	ValorHora        *float64 `json:"valor_hora,omitempty"`
+	AuxilioEstagio   *float64 `json:"auxilio_estagio,omitempty"`
+	Dependentes      *int     `json:"dependentes,omitempty"`
+}
+
+// Aplicar devolve um novo colaborador com a atualização aplicada; o original não é alterado.
+func (a AtualizacaoColaborador) Aplicar(c Colaborador) (Colaborador, error) {
+	switch TipoDe(c) {
+	case TipoEfetivo:
+		if a.HorasTrabalhadas != nil || a.ValorHora != nil || a.AuxilioEstagio != nil {
+			return nil, fmt.Errorf("%w: efetivo aceita apenas nome e salario_mensal", ErrAtualizacaoInvalida)
+		}
+	case TipoAutonomo:
+		if a.SalarioMensal != nil || a.AuxilioEstagio != nil {
+			return nil, fmt.Errorf("%w: autonomo aceita apenas nome, horas_trabalhadas e valor_hora", ErrAtualizacaoInvalida)
+		}
+	case TipoEstagiario:
+		if a.SalarioMensal != nil || a.HorasTrabalhadas != nil || a.ValorHora != nil {
+			return nil, fmt.Errorf("%w: estagiario aceita apenas nome e auxilio_estagio", ErrAtualizacaoInvalida)
+		}
+	}
+
+	// Partir do DTO garante uma cópia independente, qualquer que seja a forma armazenada.
+	dto := NovoColaboradorDTO(c)
+	if a.Nome != nil {
+		dto.Nome = *a.Nome
+	}
+	if a.SalarioMensal != nil {
+		dto.SalarioMensal = a.SalarioMensal
+	}
+	if a.HorasTrabalhadas != nil {
+		dto.HorasTrabalhadas = a.HorasTrabalhadas
+	}
+	if a.ValorHora != nil {
+		dto.ValorHora = a.ValorHora
+	}
+	if a.AuxilioEstagio != nil {
+		dto.AuxilioEstagio = a.AuxilioEstagio
+	}
+	if a.Dependentes != nil {
+		dto.Dependentes = *a.Dependentes
+	}
+	return dto.ParaColaborador()
+}
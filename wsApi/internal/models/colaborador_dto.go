@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTipoColaboradorInvalido = errors.New("tipo de colaborador inválido")
+	ErrColaboradorIncompleto   = errors.New("colaborador sem os campos do seu tipo")
+)
+
+// ColaboradorDTO é a representação JSON estável de um colaborador. Só os campos do
+// tipo informado são preenchidos; salario_calculado é ignorado na decodificação.
+type ColaboradorDTO struct {
+	Tipo             string   `json:"tipo"` // "efetivo", "autonomo", "estagiario"
+	Id               int      `json:"id"`
+	Nome             string   `json:"nome"`
+	SalarioMensal    *float64 `json:"salario_mensal,omitempty"`
+	HorasTrabalhadas *int     `json:"horas_trabalhadas,omitempty"`
+	ValorHora        *float64 `json:"valor_hora,omitempty"`
+	AuxilioEstagio   *float64 `json:"auxilio_estagio,omitempty"`
+	Dependentes      int      `json:"dependentes,omitempty"`
+	SalarioCalculado float64  `json:"salario_calculado"`
+}
+
+func NovoColaboradorDTO(c Colaborador) ColaboradorDTO {
+	dto := ColaboradorDTO{
+		Tipo:             TipoDe(c),
+		Id:               c.GetId(),
+		Nome:             c.GetNome(),
+		Dependentes:      c.GetDependentes(),
+		SalarioCalculado: c.CalcularSalario(),
+	}
+	switch v := c.(type) {
+	case Efetivo:
+		dto.SalarioMensal = &v.SalarioMensal
+	case *Efetivo:
+		dto.SalarioMensal = &v.SalarioMensal
+	case Autonomo:
+		dto.HorasTrabalhadas, dto.ValorHora = &v.HorasTrabalhadas, &v.ValorHora
+	case *Autonomo:
+		dto.HorasTrabalhadas, dto.ValorHora = &v.HorasTrabalhadas, &v.ValorHora
+	case Estagiario:
+		dto.AuxilioEstagio = &v.AuxilioEstagio
+	case *Estagiario:
+		dto.AuxilioEstagio = &v.AuxilioEstagio
+	}
+	return dto
+}
+
+func NovosColaboradoresDTO(colaboradores []Colaborador) []ColaboradorDTO {
+	dtos := make([]ColaboradorDTO, 0, len(colaboradores))
+	for _, c := range colaboradores {
+		dtos = append(dtos, NovoColaboradorDTO(c))
+	}
+	return dtos
+}
+
+// ParaColaborador reconstrói o colaborador concreto descrito pelo DTO. Os campos do
+// tipo informado são obrigatórios; nenhum deles é assumido como zero.
+func (dto ColaboradorDTO) ParaColaborador() (Colaborador, error) {
+	base := ColaboradorBase{Id: dto.Id, Nome: dto.Nome, Dependentes: dto.Dependentes}
+	switch dto.Tipo {
+	case TipoEfetivo:
+		if dto.SalarioMensal == nil {
+			return nil, fmt.Errorf("%w: efetivo %d sem salario_mensal", ErrColaboradorIncompleto, dto.Id)
+		}
+		return &Efetivo{ColaboradorBase: base, SalarioMensal: *dto.SalarioMensal}, nil
+	case TipoAutonomo:
+		if dto.HorasTrabalhadas == nil || dto.ValorHora == nil {
+			return nil, fmt.Errorf("%w: autonomo %d sem horas_trabalhadas ou valor_hora", ErrColaboradorIncompleto, dto.Id)
+		}
+		return &Autonomo{ColaboradorBase: base, HorasTrabalhadas: *dto.HorasTrabalhadas, ValorHora: *dto.ValorHora}, nil
+	case TipoEstagiario:
+		if dto.AuxilioEstagio == nil {
+			return nil, fmt.Errorf("%w: estagiario %d sem auxilio_estagio", ErrColaboradorIncompleto, dto.Id)
+		}
+		return &Estagiario{ColaboradorBase: base, AuxilioEstagio: *dto.AuxilioEstagio}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrTipoColaboradorInvalido, dto.Tipo)
+}
@@ -0,0 +1,210 @@
+package models
+
+import (
+	"math"
+	"sort"
+)
+
+// FaixaINSS é uma faixa da tabela progressiva do INSS: a alíquota incide apenas sobre
+// a parte do salário entre o limite da faixa anterior e Ate.
+type FaixaINSS struct {
+	Ate      float64 `json:"ate"`
+	Aliquota float64 `json:"aliquota"`
+}
+
+// FaixaIRRF é uma faixa da tabela do IRRF. Ate igual a zero indica a última faixa.
+type FaixaIRRF struct {
+	Ate            float64 `json:"ate"`
+	Aliquota       float64 `json:"aliquota"`
+	ParcelaDeduzir float64 `json:"parcela_deduzir"`
+}
+
+// TabelasDescontos reúne os parâmetros configuráveis do motor de descontos.
+type TabelasDescontos struct {
+	INSS                 []FaixaINSS `json:"inss"` // Em ordem crescente; o limite da última faixa é o teto
+	IRRF                 []FaixaIRRF `json:"irrf"` // Em ordem crescente
+	DeducaoPorDependente float64     `json:"deducao_por_dependente"`
+	AliquotaFGTS         float64     `json:"aliquota_fgts"`
+	// AliquotaINSSAutonomo é a contribuição do contribuinte individual, limitada ao teto do INSS.
+	AliquotaINSSAutonomo float64 `json:"aliquota_inss_autonomo"`
+}
+
+// TabelasVigentes devolve as tabelas de 2025 (INSS a partir de janeiro, IRRF a partir de maio).
+func TabelasVigentes() TabelasDescontos {
+	return TabelasDescontos{
+		INSS: []FaixaINSS{
+			{Ate: 1518.00, Aliquota: 0.075},
+			{Ate: 2793.88, Aliquota: 0.09},
+			{Ate: 4190.83, Aliquota: 0.12},
+			{Ate: 8157.41, Aliquota: 0.14},
+		},
+		IRRF: []FaixaIRRF{
+			{Ate: 2428.80, Aliquota: 0, ParcelaDeduzir: 0},
+			{Ate: 2826.65, Aliquota: 0.075, ParcelaDeduzir: 182.16},
+			{Ate: 3751.05, Aliquota: 0.15, ParcelaDeduzir: 394.16},
+			{Ate: 4664.68, Aliquota: 0.225, ParcelaDeduzir: 675.49},
+			{Ate: 0, Aliquota: 0.275, ParcelaDeduzir: 908.73},
+		},
+		DeducaoPorDependente: 189.59,
+		AliquotaFGTS:         0.08,
+		AliquotaINSSAutonomo: 0.11,
+	}
+}
+
+const (
+	DescontoINSS = "INSS"
+	DescontoIRRF = "IRRF"
+	EncargoFGTS  = "FGTS"
+)
+
+type Lancamento struct {
+	Descricao   string  `json:"descricao"`
+	BaseCalculo float64 `json:"base_calculo"`
+	Valor       float64 `json:"valor"`
+}
+
+// Holerite é o demonstrativo de um colaborador: bruto, descontos do colaborador,
+// líquido e os encargos que ficam por conta do empregador.
+type Holerite struct {
+	Competencia     string       `json:"competencia,omitempty"`
+	ColaboradorID   int          `json:"colaborador_id"`
+	Nome            string       `json:"nome"`
+	Tipo            string       `json:"tipo"`
+	Bruto           float64      `json:"bruto"`
+	Descontos       []Lancamento `json:"descontos"`
+	TotalDescontos  float64      `json:"total_descontos"`
+	Liquido         float64      `json:"liquido"`
+	Encargos        []Lancamento `json:"encargos"`
+	CustoEmpregador float64      `json:"custo_empregador"`
+}
+
+// MotorDescontos calcula holerites a partir de um conjunto de tabelas.
+//
+// Regras por tipo:
+//   - efetivo (CLT): INSS progressivo, IRRF e FGTS como encargo do empregador;
+//   - autônomo: INSS de contribuinte individual sobre o bruto (limitado ao teto) e IRRF;
+//   - estagiário: apenas IRRF, sem INSS nem FGTS (Lei 11.788/2008).
+type MotorDescontos struct {
+	Tabelas TabelasDescontos
+}
+
+func NewMotorDescontos(tabelas TabelasDescontos) *MotorDescontos {
+	return &MotorDescontos{Tabelas: tabelas}
+}
+
+func (m *MotorDescontos) Holerite(c Colaborador) Holerite {
+	return m.calcular(c.GetId(), c.GetNome(), TipoDe(c), c.GetDependentes(), c.CalcularSalario())
+}
+
+// HoleriteDaFolha calcula o holerite a partir do item de uma folha fechada: nome, tipo,
+// dependentes e bruto são os congelados no fechamento, não os atuais do colaborador.
+func (m *MotorDescontos) HoleriteDaFolha(item ItemFolha) Holerite {
+	return m.calcular(item.ColaboradorID, item.Nome, item.Tipo, item.Dependentes, item.Valor)
+}
+
+func (m *MotorDescontos) calcular(id int, nome, tipo string, dependentes int, bruto float64) Holerite {
+	h := Holerite{
+		ColaboradorID: id,
+		Nome:          nome,
+		Tipo:          tipo,
+		Bruto:         arredondar(bruto),
+		Descontos:     []Lancamento{},
+		Encargos:      []Lancamento{},
+	}
+
+	inss := 0.0
+	switch h.Tipo {
+	case TipoEfetivo:
+		inss = m.INSS(h.Bruto)
+	case TipoAutonomo:
+		inss = m.INSSAutonomo(h.Bruto)
+	}
+	if inss > 0 {
+		h.Descontos = append(h.Descontos, Lancamento{Descricao: DescontoINSS, BaseCalculo: h.Bruto, Valor: inss})
+	}
+
+	baseIRRF := math.Max(0, arredondar(h.Bruto-inss-float64(dependentes)*m.Tabelas.DeducaoPorDependente))
+	if irrf := m.IRRF(baseIRRF); irrf > 0 {
+		h.Descontos = append(h.Descontos, Lancamento{Descricao: DescontoIRRF, BaseCalculo: baseIRRF, Valor: irrf})
+	}
+
+	if h.Tipo == TipoEfetivo {
+		fgts := arredondar(h.Bruto * m.Tabelas.AliquotaFGTS)
+		h.Encargos = append(h.Encargos, Lancamento{Descricao: EncargoFGTS, BaseCalculo: h.Bruto, Valor: fgts})
+	}
+
+	for _, d := range h.Descontos {
+		h.TotalDescontos += d.Valor
+	}
+	h.TotalDescontos = arredondar(h.TotalDescontos)
+	h.Liquido = arredondar(h.Bruto - h.TotalDescontos)
+	h.CustoEmpregador = h.Bruto
+	for _, e := range h.Encargos {
+		h.CustoEmpregador += e.Valor
+	}
+	h.CustoEmpregador = arredondar(h.CustoEmpregador)
+	return h
+}
+
+// INSS aplica a tabela progressiva faixa a faixa; acima do teto a contribuição é fixa.
+func (m *MotorDescontos) INSS(salario float64) float64 {
+	total, anterior := 0.0, 0.0
+	for _, f := range m.Tabelas.INSS {
+		if salario <= anterior {
+			break
+		}
+		total += (math.Min(salario, f.Ate) - anterior) * f.Aliquota
+		anterior = f.Ate
+	}
+	return arredondar(total)
+}
+
+func (m *MotorDescontos) INSSAutonomo(bruto float64) float64 {
+	base := bruto
+	if n := len(m.Tabelas.INSS); n > 0 {
+		base = math.Min(base, m.Tabelas.INSS[n-1].Ate)
+	}
+	return arredondar(base * m.Tabelas.AliquotaINSSAutonomo)
+}
+
+// IRRF usa a alíquota da faixa em que a base se encaixa menos a parcela a deduzir.
+func (m *MotorDescontos) IRRF(base float64) float64 {
+	for _, f := range m.Tabelas.IRRF {
+		if f.Ate == 0 || base <= f.Ate {
+			return arredondar(math.Max(0, base*f.Aliquota-f.ParcelaDeduzir))
+		}
+	}
+	return 0
+}
+
+// ResumoFolha totaliza os holerites de um departamento.
+type ResumoFolha struct {
+	TotalBruto      float64    `json:"total_bruto"`
+	TotalDescontos  float64    `json:"total_descontos"`
+	TotalLiquido    float64    `json:"total_liquido"`
+	CustoEmpregador float64    `json:"custo_empregador"`
+	Holerites       []Holerite `json:"holerites,omitempty"`
+}
+
+// ResumirFolha calcula o holerite de cada colaborador (em ordem de ID) e os totais.
+func (d *Departamento) ResumirFolha(m *MotorDescontos) ResumoFolha {
+	var r ResumoFolha
+	for _, c := range d.Colaboradores {
+		h := m.Holerite(c)
+		r.Holerites = append(r.Holerites, h)
+		r.TotalBruto += h.Bruto
+		r.TotalDescontos += h.TotalDescontos
+		r.TotalLiquido += h.Liquido
+		r.CustoEmpregador += h.CustoEmpregador
+	}
+	sort.Slice(r.Holerites, func(i, j int) bool { return r.Holerites[i].ColaboradorID < r.Holerites[j].ColaboradorID })
+	r.TotalBruto = arredondar(r.TotalBruto)
+	r.TotalDescontos = arredondar(r.TotalDescontos)
+	r.TotalLiquido = arredondar(r.TotalLiquido)
+	r.CustoEmpregador = arredondar(r.CustoEmpregador)
+	return r
+}
+
+func arredondar(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,103 @@
+package models
+
+import "testing"
+
+func TestINSSNasFronteirasDasFaixas(t *testing.T) {
+	m := NewMotorDescontos(TabelasVigentes())
+	casos := []struct {
+		salario float64
+		inss    float64
+	}{
+		{0, 0},
+		{1518.00, 113.85},
+		{1518.01, 113.85},
+		{2793.88, 228.68},
+		{2793.89, 228.68},
+		{4190.83, 396.31},
+		{4190.84, 396.31},
+		{8157.41, 951.63},
+		{8157.42, 951.63}, // Acima do teto a contribuição não cresce
+		{20000, 951.63},
+	}
+	for _, c := range casos {
+		if got := m.INSS(c.salario); got != c.inss {
+			t.Errorf("INSS(%.2f) = %.2f, esperava %.2f", c.salario, got, c.inss)
+		}
+	}
+}
+
+func TestIRRFNasFronteirasDasFaixas(t *testing.T) {
+	m := NewMotorDescontos(TabelasVigentes())
+	casos := []struct {
+		base float64
+		irrf float64
+	}{
+		{0, 0},
+		{2428.80, 0},
+		{2428.81, 0},
+		{2826.65, 29.84},
+		{2826.66, 29.84},
+		{3751.05, 168.50},
+		{3751.06, 168.50},
+		{4664.68, 374.06},
+		{4664.69, 374.06},
+		{10000, 1841.27},
+	}
+	for _, c := range casos {
+		if got := m.IRRF(c.base); got != c.irrf {
+			t.Errorf("IRRF(%.2f) = %.2f, esperava %.2f", c.base, got, c.irrf)
+		}
+	}
+}
+
+func TestHoleritePorTipoEDependentes(t *testing.T) {
+	m := NewMotorDescontos(TabelasVigentes())
+	casos := []struct {
+		nome        string
+		tipo        string
+		dependentes int
+		bruto       float64
+		inss        float64
+		baseIRRF    float64
+		irrf        float64
+		fgts        float64
+	}{
+		{"efetivo sem dependentes", TipoEfetivo, 0, 5000, 509.60, 4490.40, 334.85, 400},
+		{"efetivo com dois dependentes", TipoEfetivo, 2, 5000, 509.60, 4111.22, 249.53, 400},
+		{"efetivo acima do teto", TipoEfetivo, 0, 9000, 951.63, 8048.37, 1304.57, 720},
+		{"dependentes zeram a base", TipoEfetivo, 20, 3000, 253.41, 0, 0, 240},
+		{"autonomo limitado ao teto", TipoAutonomo, 0, 10000, 897.32, 9102.68, 1594.51, 0},
+		{"estagiario sem INSS nem FGTS", TipoEstagiario, 1, 3000, 0, 2810.41, 28.62, 0},
+	}
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			h := m.calcular(1, "Ana", c.tipo, c.dependentes, c.bruto)
+			var inss, irrf, baseIRRF, fgts float64
+			for _, d := range h.Descontos {
+				switch d.Descricao {
+				case DescontoINSS:
+					inss = d.Valor
+				case DescontoIRRF:
+					irrf, baseIRRF = d.Valor, d.BaseCalculo
+				}
+			}
+			for _, e := range h.Encargos {
+				if e.Descricao == EncargoFGTS {
+					fgts = e.Valor
+				}
+			}
+			if inss != c.inss || irrf != c.irrf || fgts != c.fgts {
+				t.Errorf("INSS %.2f, IRRF %.2f, FGTS %.2f; esperava %.2f, %.2f, %.2f", inss, irrf, fgts, c.inss, c.irrf, c.fgts)
+			}
+			if irrf > 0 && baseIRRF != c.baseIRRF {
+				t.Errorf("base do IRRF %.2f, esperava %.2f", baseIRRF, c.baseIRRF)
+			}
+			if liquido := arredondar(c.bruto - c.inss - c.irrf); h.Liquido != liquido {
+				t.Errorf("líquido %.2f, esperava %.2f", h.Liquido, liquido)
+			}
+			if custo := arredondar(c.bruto + c.fgts); h.CustoEmpregador != custo {
+				t.Errorf("custo do empregador %.2f, esperava %.2f", h.CustoEmpregador, custo)
+			}
+		})
+	}
+}
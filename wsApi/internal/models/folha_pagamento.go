@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// FormatoCompetencia é o layout do mês de competência de uma folha (AAAA-MM).
+const FormatoCompetencia = "2006-01"
+
+var ErrCompetenciaInvalida = errors.New("competência inválida; use o formato AAAA-MM")
+
+type ItemFolha struct {
+	ColaboradorID int     `json:"colaborador_id"`
+	Nome          string  `json:"nome"`
+	Tipo          string  `json:"tipo"`
+	Valor         float64 `json:"valor"`
+	// Dependentes é congelado junto com o bruto para que a segunda via do holerite
+	// repita o IRRF do fechamento.
+	Dependentes int `json:"dependentes,omitempty"`
+}
+
+type TotalPorTipo struct {
+	Quantidade int     `json:"quantidade"`
+	Total      float64 `json:"total"`
+}
+
+// FolhaPagamento é uma folha fechada: os valores ficam congelados no momento do
+// fechamento e não acompanham alterações posteriores nos colaboradores.
+type FolhaPagamento struct {
+	Departamento  string                  `json:"departamento"`
+	Competencia   string                  `json:"competencia"`
+	FechadaEm     time.Time               `json:"fechada_em"`
+	Itens         []ItemFolha             `json:"itens"`
+	TotaisPorTipo map[string]TotalPorTipo `json:"totais_por_tipo"`
+	Total         float64                 `json:"total"`
+}
+
+// FecharFolha calcula a folha do departamento para a competência informada.
+func FecharFolha(d Departamento, competencia string, fechadaEm time.Time) (FolhaPagamento, error) {
+	if _, err := time.Parse(FormatoCompetencia, competencia); err != nil {
+		return FolhaPagamento{}, fmt.Errorf("%w: %q", ErrCompetenciaInvalida, competencia)
+	}
+
+	folha := FolhaPagamento{
+		Departamento:  d.Nome,
+		Competencia:   competencia,
+		FechadaEm:     fechadaEm,
+		Itens:         make([]ItemFolha, 0, len(d.Colaboradores)),
+		TotaisPorTipo: make(map[string]TotalPorTipo),
+	}
+	for _, c := range d.Colaboradores {
+		item := ItemFolha{
+			ColaboradorID: c.GetId(),
+			Nome:          c.GetNome(),
+			Tipo:          TipoDe(c),
+			Valor:         c.CalcularSalario(),
+			Dependentes:   c.GetDependentes(),
+		}
+		folha.Itens = append(folha.Itens, item)
+
+		total := folha.TotaisPorTipo[item.Tipo]
+		total.Quantidade++
+		total.Total += item.Valor
+		folha.TotaisPorTipo[item.Tipo] = total
+		folha.Total += item.Valor
+	}
+	sort.Slice(folha.Itens, func(i, j int) bool { return folha.Itens[i].ColaboradorID < folha.Itens[j].ColaboradorID })
+	return folha, nil
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatoTexto = "texto"
+	FormatoCSV   = "csv"
+	FormatoJSON  = "json"
+)
+
+var ErrFormatoInvalido = errors.New("formato de holerite inválido; use texto, csv ou json")
+
+// ContentTypeHolerite devolve o Content-Type HTTP correspondente ao formato.
+func ContentTypeHolerite(formato string) string {
+	switch formato {
+	case FormatoTexto:
+		return "text/plain; charset=utf-8"
+	case FormatoCSV:
+		return "text/csv; charset=utf-8"
+	}
+	return "application/json"
+}
+
+// EscreverHolerite serializa o holerite no formato pedido.
+func EscreverHolerite(w io.Writer, h Holerite, formato string) error {
+	switch formato {
+	case FormatoTexto:
+		return escreverHoleriteTexto(w, h)
+	case FormatoCSV:
+		return escreverHoleriteCSV(w, h)
+	case FormatoJSON:
+		return json.NewEncoder(w).Encode(h)
+	}
+	return fmt.Errorf("%w: %q", ErrFormatoInvalido, formato)
+}
+
+const larguraHolerite = 52
+
+func escreverHoleriteTexto(w io.Writer, h Holerite) error {
+	var b strings.Builder
+	linha := strings.Repeat("-", larguraHolerite) + "\n"
+	valor := func(descricao string, v float64) {
+		fmt.Fprintf(&b, "%-36s%16s\n", descricao, formatarReais(v))
+	}
+
+	fmt.Fprintf(&b, "HOLERITE - Competência %s\n", h.Competencia)
+	fmt.Fprintf(&b, "Colaborador: %d - %s (%s)\n", h.ColaboradorID, h.Nome, h.Tipo)
+	b.WriteString(linha)
+	valor("Salário bruto", h.Bruto)
+	for _, d := range h.Descontos {
+		valor(fmt.Sprintf("(-) %s (base %s)", d.Descricao, formatarReais(d.BaseCalculo)), d.Valor)
+	}
+	valor("Total de descontos", h.TotalDescontos)
+	valor("Salário líquido", h.Liquido)
+	if len(h.Encargos) > 0 {
+		b.WriteString(linha)
+		b.WriteString("Encargos do empregador (não descontados)\n")
+		for _, e := range h.Encargos {
+			valor(fmt.Sprintf("%s (base %s)", e.Descricao, formatarReais(e.BaseCalculo)), e.Valor)
+		}
+	}
+	valor("Custo total para o empregador", h.CustoEmpregador)
+	b.WriteString(linha)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// O CSV tem uma rubrica por linha para ser lido sem conhecer os descontos de antemão.
+func escreverHoleriteCSV(w io.Writer, h Holerite) error {
+	cw := csv.NewWriter(w)
+	id := strconv.Itoa(h.ColaboradorID)
+	registro := func(rubrica string, base, valor float64) []string {
+		baseTexto := ""
+		if base != 0 {
+			baseTexto = strconv.FormatFloat(base, 'f', 2, 64)
+		}
+		return []string{h.Competencia, id, h.Nome, h.Tipo, rubrica, baseTexto, strconv.FormatFloat(valor, 'f', 2, 64)}
+	}
+
+	registros := [][]string{
+		{"competencia", "colaborador_id", "nome", "tipo", "rubrica", "base_calculo", "valor"},
+		registro("BRUTO", 0, h.Bruto),
+	}
+	for _, d := range h.Descontos {
+		registros = append(registros, registro(d.Descricao, d.BaseCalculo, d.Valor))
+	}
+	registros = append(registros, registro("TOTAL_DESCONTOS", 0, h.TotalDescontos), registro("LIQUIDO", 0, h.Liquido))
+	for _, e := range h.Encargos {
+		registros = append(registros, registro(e.Descricao, e.BaseCalculo, e.Valor))
+	}
+	registros = append(registros, registro("CUSTO_EMPREGADOR", 0, h.CustoEmpregador))
+
+	return cw.WriteAll(registros)
+}
+
+// formatarReais formata no padrão brasileiro: R$ 1.234,56.
+func formatarReais(v float64) string {
+	sinal := ""
+	if v < 0 {
+		sinal, v = "-", -v
+	}
+	texto := strconv.FormatFloat(v, 'f', 2, 64)
+	inteiro, centavos := texto[:len(texto)-3], texto[len(texto)-2:]
+
+	var milhares []string
+	for len(inteiro) > 3 {
+		milhares = append([]string{inteiro[len(inteiro)-3:]}, milhares...)
+		inteiro = inteiro[:len(inteiro)-3]
+	}
+	milhares = append([]string{inteiro}, milhares...)
+	return "R$ " + sinal + strings.Join(milhares, ".") + "," + centavos
+}
@@ -8,13 +8,15 @@ type Colaborador interface {
 	CalcularSalario() float64
 	GetId() int
 	GetNome() string
+	GetDependentes() int
 }
 
 ///////////////////////////////////////////////////////////////
 
 type ColaboradorBase struct {
-	Id   int
-	Nome string
+	Id          int
+	Nome        string
+	Dependentes int // Usado na dedução do IRRF
 }
 
 func (c ColaboradorBase) Identificar() string {
@@ -29,6 +31,10 @@ func (c ColaboradorBase) GetNome() string {
 	return c.Nome
 }
 
+func (c ColaboradorBase) GetDependentes() int {
+	return c.Dependentes
+}
+
 ///////////////////////////////////////////////////////////////
 
 type Autonomo struct{
@@ -64,13 +70,41 @@ func (e Estagiario) CalcularSalario() float64{
 
 ///////////////////////////////////////////////////////////////
 
+const (
+	TipoEfetivo    = "efetivo"
+	TipoAutonomo   = "autonomo"
+	TipoEstagiario = "estagiario"
+)
+
+// TipoDe devolve o tipo do colaborador ("efetivo", "autonomo" ou "estagiario"),
+// aceitando tanto valores quanto ponteiros.
+func TipoDe(c Colaborador) string {
+	switch c.(type) {
+	case Efetivo, *Efetivo:
+		return TipoEfetivo
+	case Autonomo, *Autonomo:
+		return TipoAutonomo
+	case Estagiario, *Estagiario:
+		return TipoEstagiario
+	}
+	return ""
+}
+
+///////////////////////////////////////////////////////////////
+
 type Departamento struct {
 	Nome          string
 	Colaboradores []Colaborador
 }
 
-func (d *Departamento) AdicionarColaborador(c Colaborador) {
+func (d *Departamento) AdicionarColaborador(c Colaborador) error {
+	for _, existente := range d.Colaboradores {
+		if existente.GetId() == c.GetId() {
+			return fmt.Errorf("%w: ID %d já existe no departamento '%s'", ErrIDEmUso, c.GetId(), d.Nome)
+		}
+	}
 	d.Colaboradores = append(d.Colaboradores, c)
+	return nil
 }
 
 func (d *Departamento) DemitirColaborador(id int)error {
@@ -89,6 +123,22 @@ func (d *Departamento) DemitirColaborador(id int)error {
 	return nil
 }
 
+// AtualizarColaborador substitui o colaborador pelo resultado da atualização, na mesma posição.
+func (d *Departamento) AtualizarColaborador(id int, a AtualizacaoColaborador) (Colaborador, error) {
+	for i, c := range d.Colaboradores {
+		if c.GetId() != id {
+			continue
+		}
+		atualizado, err := a.Aplicar(c)
+		if err != nil {
+			return nil, err
+		}
+		d.Colaboradores[i] = atualizado
+		return atualizado, nil
+	}
+	return nil, fmt.Errorf("%w: ID %d", ErrColaboradorNaoEncontrado, id)
+}
+
 func (d *Departamento) CalcularFolhaSalarial() float64 {
 	total := 0.0
 
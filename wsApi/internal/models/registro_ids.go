@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrIDEmUso = errors.New("ID de colaborador já está em uso")
+
+// RegistroIDs garante que cada ID de colaborador pertença a um único departamento em
+// toda a empresa. Também aloca IDs novos quando o cliente não informa um (ID 0).
+type RegistroIDs struct {
+	mu    sync.Mutex
+	donos map[int]string // ID -> nome do departamento
+	maior int
+}
+
+func NewRegistroIDs() *RegistroIDs {
+	return &RegistroIDs{donos: make(map[int]string)}
+}
+
+// Reservar associa o ID ao departamento, falhando se ele já pertencer a alguém.
+func (r *RegistroIDs) Reservar(id int, departamento string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if dono, ok := r.donos[id]; ok {
+		return fmt.Errorf("%w: ID %d já pertence a um colaborador do departamento '%s'", ErrIDEmUso, id, dono)
+	}
+	r.donos[id] = departamento
+	if id > r.maior {
+		r.maior = id
+	}
+	return nil
+}
+
+// Alocar reserva para o departamento um ID acima de todos os já vistos. IDs liberados
+// não são reaproveitados.
+func (r *RegistroIDs) Alocar(departamento string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maior++
+	r.donos[r.maior] = departamento
+	return r.maior
+}
+
+func (r *RegistroIDs) Liberar(ids ...int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		delete(r.donos, id)
+	}
+}
+
+// RenomearDepartamento mantém as mensagens de conflito coerentes após um rename.
+func (r *RegistroIDs) RenomearDepartamento(nome, novoNome string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, dono := range r.donos {
+		if dono == nome {
+			r.donos[id] = novoNome
+		}
+	}
+}
+
+// ComId devolve uma cópia do colaborador com o ID informado.
+func ComId(c Colaborador, id int) (Colaborador, error) {
+	dto := NovoColaboradorDTO(c)
+	dto.Id = id
+	return dto.ParaColaborador()
+}
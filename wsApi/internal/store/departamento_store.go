@@ -0,0 +1,274 @@
+package store
+
+import (
+	"errors"
+	"sgrh/internal/models"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrDepartamentoNaoEncontrado    = errors.New("departamento não encontrado")
+	ErrDepartamentoJaExiste         = errors.New("departamento já existe")
+	ErrDepartamentoComColaboradores = errors.New("departamento possui colaboradores")
+	ErrFolhaJaFechada               = errors.New("folha desta competência já foi fechada")
+	ErrFolhaNaoEncontrada           = errors.New("nenhuma folha fechada para esta competência")
+)
+
+// entrada protege um departamento com seu próprio RWMutex, para que operações em
+// departamentos diferentes não disputem o mesmo lock.
+type entrada struct {
+	mu       sync.RWMutex
+	depto    *models.Departamento
+	folhas   map[string]models.FolhaPagamento // competência -> folha fechada
+	removido bool                             // marcado quando o departamento sai do mapa enquanto alguém ainda segura a entrada
+}
+
+// DepartamentoStore é o único caminho de acesso aos departamentos para os handlers HTTP.
+// O lock do store protege apenas o mapa; o conteúdo de cada departamento fica sob o lock
+// da sua entrada. Quando os dois são necessários, o do store é sempre adquirido primeiro.
+// O registro de IDs tem lock próprio e é sempre o último a ser adquirido.
+type DepartamentoStore struct {
+	mu     sync.RWMutex
+	deptos map[string]*entrada
+	ids    *models.RegistroIDs
+}
+
+func NewDepartamentoStore() *DepartamentoStore {
+	return &DepartamentoStore{deptos: make(map[string]*entrada), ids: models.NewRegistroIDs()}
+}
+
+func (s *DepartamentoStore) buscar(nome string) (*entrada, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.deptos[nome]
+	if !ok {
+		return nil, ErrDepartamentoNaoEncontrado
+	}
+	return e, nil
+}
+
+// copiar devolve um instantâneo do departamento que pode ser lido sem lock.
+func copiar(d *models.Departamento) models.Departamento {
+	return models.Departamento{
+		Nome:          d.Nome,
+		Colaboradores: append([]models.Colaborador(nil), d.Colaboradores...),
+	}
+}
+
+func (s *DepartamentoStore) Criar(nome string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deptos[nome]; ok {
+		return ErrDepartamentoJaExiste
+	}
+	s.deptos[nome] = &entrada{depto: &models.Departamento{Nome: nome}}
+	return nil
+}
+
+// Listar devolve instantâneos de todos os departamentos, ordenados por nome.
+func (s *DepartamentoStore) Listar() []models.Departamento {
+	s.mu.RLock()
+	entradas := make([]*entrada, 0, len(s.deptos))
+	for _, e := range s.deptos {
+		entradas = append(entradas, e)
+	}
+	s.mu.RUnlock()
+
+	deptos := make([]models.Departamento, 0, len(entradas))
+	for _, e := range entradas {
+		e.mu.RLock()
+		if !e.removido {
+			deptos = append(deptos, copiar(e.depto))
+		}
+		e.mu.RUnlock()
+	}
+	sort.Slice(deptos, func(i, j int) bool { return deptos[i].Nome < deptos[j].Nome })
+	return deptos
+}
+
+// Obter devolve um instantâneo do departamento.
+func (s *DepartamentoStore) Obter(nome string) (models.Departamento, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return models.Departamento{}, err
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.removido {
+		return models.Departamento{}, ErrDepartamentoNaoEncontrado
+	}
+	return copiar(e.depto), nil
+}
+
+func (s *DepartamentoStore) Renomear(nome, novoNome string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.deptos[nome]
+	if !ok {
+		return ErrDepartamentoNaoEncontrado
+	}
+	if nome == novoNome {
+		return nil
+	}
+	if _, ok := s.deptos[novoNome]; ok {
+		return ErrDepartamentoJaExiste
+	}
+
+	e.mu.Lock()
+	e.depto.Nome = novoNome
+	s.ids.RenomearDepartamento(nome, novoNome)
+	e.mu.Unlock()
+	delete(s.deptos, nome)
+	s.deptos[novoNome] = e
+	return nil
+}
+
+// Remover exclui o departamento. Sem force, falha se ainda houver colaboradores.
+// Devolve quantos colaboradores foram desligados junto com o departamento.
+func (s *DepartamentoStore) Remover(nome string, force bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.deptos[nome]
+	if !ok {
+		return 0, ErrDepartamentoNaoEncontrado
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	total := len(e.depto.Colaboradores)
+	if total > 0 && !force {
+		return total, ErrDepartamentoComColaboradores
+	}
+	e.removido = true
+	delete(s.deptos, nome)
+	ids := make([]int, 0, total)
+	for _, c := range e.depto.Colaboradores {
+		ids = append(ids, c.GetId())
+	}
+	s.ids.Liberar(ids...)
+	return total, nil
+}
+
+// AdicionarColaborador rejeita IDs já usados em qualquer departamento. Colaboradores com
+// ID 0 recebem um ID alocado pelo store; o colaborador efetivamente gravado é devolvido.
+func (s *DepartamentoStore) AdicionarColaborador(nome string, c models.Colaborador) (models.Colaborador, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.removido {
+		return nil, ErrDepartamentoNaoEncontrado
+	}
+
+	if c.GetId() == 0 {
+		if c, err = models.ComId(c, s.ids.Alocar(nome)); err != nil {
+			return nil, err
+		}
+	} else if err := s.ids.Reservar(c.GetId(), nome); err != nil {
+		return nil, err
+	}
+	if err := e.depto.AdicionarColaborador(c); err != nil {
+		s.ids.Liberar(c.GetId())
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *DepartamentoStore) DemitirColaborador(nome string, id int) error {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.removido {
+		return ErrDepartamentoNaoEncontrado
+	}
+	if err := e.depto.DemitirColaborador(id); err != nil {
+		return err
+	}
+	s.ids.Liberar(id)
+	return nil
+}
+
+func (s *DepartamentoStore) AtualizarColaborador(nome string, id int, a models.AtualizacaoColaborador) (models.Colaborador, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.removido {
+		return nil, ErrDepartamentoNaoEncontrado
+	}
+	return e.depto.AtualizarColaborador(id, a)
+}
+
+// FecharFolha congela a folha do departamento para a competência. Cada competência só
+// pode ser fechada uma vez; as folhas fechadas acompanham o departamento num rename.
+func (s *DepartamentoStore) FecharFolha(nome, competencia string) (models.FolhaPagamento, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return models.FolhaPagamento{}, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.removido {
+		return models.FolhaPagamento{}, ErrDepartamentoNaoEncontrado
+	}
+	if _, fechada := e.folhas[competencia]; fechada {
+		return models.FolhaPagamento{}, ErrFolhaJaFechada
+	}
+
+	folha, err := models.FecharFolha(*e.depto, competencia, time.Now())
+	if err != nil {
+		return models.FolhaPagamento{}, err
+	}
+	if e.folhas == nil {
+		e.folhas = make(map[string]models.FolhaPagamento)
+	}
+	e.folhas[competencia] = folha
+	return folha, nil
+}
+
+// ListarFolhas devolve as folhas fechadas do departamento em ordem de competência.
+// Folhas nunca são alteradas depois de fechadas, então podem ser compartilhadas sem cópia.
+func (s *DepartamentoStore) ListarFolhas(nome string) ([]models.FolhaPagamento, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return nil, err
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.removido {
+		return nil, ErrDepartamentoNaoEncontrado
+	}
+
+	folhas := make([]models.FolhaPagamento, 0, len(e.folhas))
+	for _, f := range e.folhas {
+		folhas = append(folhas, f)
+	}
+	sort.Slice(folhas, func(i, j int) bool { return folhas[i].Competencia < folhas[j].Competencia })
+	return folhas, nil
+}
+
+func (s *DepartamentoStore) ObterFolha(nome, competencia string) (models.FolhaPagamento, error) {
+	e, err := s.buscar(nome)
+	if err != nil {
+		return models.FolhaPagamento{}, err
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.removido {
+		return models.FolhaPagamento{}, ErrDepartamentoNaoEncontrado
+	}
+	folha, ok := e.folhas[competencia]
+	if !ok {
+		return models.FolhaPagamento{}, ErrFolhaNaoEncontrada
+	}
+	return folha, nil
+}
@@ -0,0 +1,341 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"sgrh/internal/models"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	trabalhadoresPorDepto = 8
+	adicoesPorTrabalhador = 50
+	idsDisputados         = 200
+	primeiroIDDisputado   = 100000
+	prazoDasTentativas    = 5 * time.Second
+)
+
+func novoEfetivo(id int) models.Colaborador {
+	return &models.Efetivo{
+		ColaboradorBase: models.ColaboradorBase{Id: id, Nome: fmt.Sprintf("colab-%d", id)},
+		SalarioMensal:   1000,
+	}
+}
+
+func novoStore(t *testing.T, nomes ...string) *DepartamentoStore {
+	t.Helper()
+	s := NewDepartamentoStore()
+	for _, nome := range nomes {
+		if err := s.Criar(nome); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+// emitidos acumula os IDs que as goroutines conseguiram gravar.
+type emitidos struct {
+	mu  sync.Mutex
+	ids []int
+}
+
+func (e *emitidos) registrar(id int) {
+	e.mu.Lock()
+	e.ids = append(e.ids, id)
+	e.mu.Unlock()
+}
+
+// lerDuranteOTeste mantém leitores concorrentes até a função devolvida ser chamada.
+func lerDuranteOTeste(s *DepartamentoStore, nome string) (parar func()) {
+	var leitores sync.WaitGroup
+	fim := make(chan struct{})
+	for r := 0; r < 4; r++ {
+		leitores.Add(1)
+		go func() {
+			defer leitores.Done()
+			for {
+				select {
+				case <-fim:
+					return
+				default:
+				}
+				for _, d := range s.Listar() {
+					_ = d.CalcularFolhaSalarial()
+				}
+				if d, err := s.Obter(nome); err == nil {
+					_ = len(d.Colaboradores)
+				}
+			}
+		}()
+	}
+	return func() {
+		close(fim)
+		leitores.Wait()
+	}
+}
+
+// emQualquerNome repete a operação com os nomes que o departamento alterna durante um
+// rename, até ela encontrar o departamento ou o prazo acabar.
+func emQualquerNome(nomes []string, op func(nome string) error) error {
+	prazo := time.Now().Add(prazoDasTentativas)
+	for time.Now().Before(prazo) {
+		for _, nome := range nomes {
+			if err := op(nome); !errors.Is(err, ErrDepartamentoNaoEncontrado) {
+				return err
+			}
+		}
+	}
+	return fmt.Errorf("departamento não encontrado com nenhum dos nomes %v em %s", nomes, prazoDasTentativas)
+}
+
+// adicionarEDemitir adiciona colaboradores com ID alocado pelo store e demite metade.
+func adicionarEDemitir(t *testing.T, gravados *emitidos, adicionar func(models.Colaborador) (models.Colaborador, error), demitir func(int) error) {
+	for i := 0; i < adicoesPorTrabalhador; i++ {
+		c, err := adicionar(novoEfetivo(0))
+		if err != nil {
+			t.Errorf("adicionar: %v", err)
+			return
+		}
+		gravados.registrar(c.GetId())
+		if i%2 == 0 {
+			if err := demitir(c.GetId()); err != nil {
+				t.Errorf("demitir %d: %v", c.GetId(), err)
+				return
+			}
+		}
+	}
+}
+
+// conferirColaboradores verifica quantos colaboradores cada departamento terminou tendo
+// e devolve em qual departamento está cada ID.
+func conferirColaboradores(t *testing.T, s *DepartamentoStore, esperados map[string]int) map[int]string {
+	t.Helper()
+	deptos := s.Listar()
+	if len(deptos) != len(esperados) {
+		t.Errorf("esperava %d departamentos, encontrei %d", len(esperados), len(deptos))
+	}
+	presentes := make(map[int]string)
+	for _, d := range deptos {
+		esperado, ok := esperados[d.Nome]
+		if !ok {
+			t.Errorf("departamento inesperado: %s", d.Nome)
+		} else if len(d.Colaboradores) != esperado {
+			t.Errorf("departamento %s: esperava %d colaboradores, encontrei %d", d.Nome, esperado, len(d.Colaboradores))
+		}
+		for _, c := range d.Colaboradores {
+			if dono, repetido := presentes[c.GetId()]; repetido {
+				t.Errorf("ID %d presente em %s e %s", c.GetId(), dono, d.Nome)
+			}
+			presentes[c.GetId()] = d.Nome
+		}
+	}
+	return presentes
+}
+
+// conferirRegistro usa o próprio store para confirmar que o registro de IDs conhece
+// exatamente os IDs presentes: um ID em uso é recusado em outro departamento, com o dono
+// correto na mensagem, e um ID liberado pode ser gravado de novo.
+func conferirRegistro(t *testing.T, s *DepartamentoStore, gravados *emitidos, presentes map[int]string, outro string) {
+	t.Helper()
+	for _, id := range gravados.ids {
+		depto, presente := presentes[id]
+		_, err := s.AdicionarColaborador(outro, novoEfetivo(id))
+		switch {
+		case presente && !errors.Is(err, models.ErrIDEmUso):
+			t.Errorf("ID %d está em %s mas foi aceito em %s: %v", id, depto, outro, err)
+		case presente && !strings.Contains(err.Error(), "'"+depto+"'"):
+			t.Errorf("ID %d está em %s mas o registro diz: %v", id, depto, err)
+		case !presente && err != nil:
+			t.Errorf("ID %d foi liberado mas continua registrado: %v", id, err)
+		case !presente:
+			if err := s.DemitirColaborador(outro, id); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// Os testes abaixo devem ser executados com -race.
+func TestStoreConcorrente(t *testing.T) {
+	t.Run("AdicoesEDemissoes", func(t *testing.T) {
+		deptos := []string{"TI", "RH", "Financeiro"}
+		s := novoStore(t, append(deptos, "Conferencia")...)
+		gravados := &emitidos{}
+		parar := lerDuranteOTeste(s, deptos[0])
+
+		var trabalho sync.WaitGroup
+		for _, nome := range deptos {
+			for w := 0; w < trabalhadoresPorDepto; w++ {
+				trabalho.Add(1)
+				go func() {
+					defer trabalho.Done()
+					adicionarEDemitir(t, gravados,
+						func(c models.Colaborador) (models.Colaborador, error) { return s.AdicionarColaborador(nome, c) },
+						func(id int) error { return s.DemitirColaborador(nome, id) },
+					)
+				}()
+			}
+		}
+		trabalho.Wait()
+		parar()
+		if t.Failed() {
+			return
+		}
+
+		esperados := map[string]int{"Conferencia": 0}
+		for _, nome := range deptos {
+			esperados[nome] = trabalhadoresPorDepto * adicoesPorTrabalhador / 2
+		}
+		presentes := conferirColaboradores(t, s, esperados)
+		conferirRegistro(t, s, gravados, presentes, "Conferencia")
+	})
+
+	t.Run("IDsDisputados", func(t *testing.T) {
+		deptos := []string{"TI", "RH", "Financeiro"}
+		s := novoStore(t, append(deptos, "Conferencia")...)
+		gravados := &emitidos{}
+		parar := lerDuranteOTeste(s, deptos[0])
+
+		// Todos os departamentos disputam os mesmos IDs explícitos; só um pode vencer cada um.
+		var (
+			trabalho   sync.WaitGroup
+			muVenc     sync.Mutex
+			vencedores = make([]int, idsDisputados)
+		)
+		for _, nome := range deptos {
+			trabalho.Add(1)
+			go func() {
+				defer trabalho.Done()
+				for i := 0; i < idsDisputados; i++ {
+					id := primeiroIDDisputado + i
+					_, err := s.AdicionarColaborador(nome, novoEfetivo(id))
+					if errors.Is(err, models.ErrIDEmUso) {
+						continue
+					}
+					if err != nil {
+						t.Errorf("adicionar ID %d: %v", id, err)
+						return
+					}
+					muVenc.Lock()
+					vencedores[i]++
+					muVenc.Unlock()
+					gravados.registrar(id)
+				}
+			}()
+		}
+		trabalho.Wait()
+		parar()
+		if t.Failed() {
+			return
+		}
+
+		for i, n := range vencedores {
+			if n != 1 {
+				t.Errorf("ID %d foi aceito %d vezes", primeiroIDDisputado+i, n)
+			}
+		}
+		presentes := make(map[int]string)
+		for _, d := range s.Listar() {
+			for _, c := range d.Colaboradores {
+				presentes[c.GetId()] = d.Nome
+			}
+		}
+		if len(presentes) != idsDisputados {
+			t.Errorf("esperava %d colaboradores no total, encontrei %d", idsDisputados, len(presentes))
+		}
+		conferirRegistro(t, s, gravados, presentes, "Conferencia")
+	})
+
+	t.Run("RenomearDuranteAdicoes", func(t *testing.T) {
+		nomes := []string{"Vendas", "Comercial"}
+		s := novoStore(t, nomes[0], "Conferencia")
+		gravados := &emitidos{}
+		parar := lerDuranteOTeste(s, nomes[0])
+
+		var trabalho sync.WaitGroup
+		for w := 0; w < trabalhadoresPorDepto; w++ {
+			trabalho.Add(1)
+			go func() {
+				defer trabalho.Done()
+				adicionarEDemitir(t, gravados,
+					func(c models.Colaborador) (adicionado models.Colaborador, err error) {
+						err = emQualquerNome(nomes, func(nome string) error {
+							adicionado, err = s.AdicionarColaborador(nome, c)
+							return err
+						})
+						return adicionado, err
+					},
+					func(id int) error {
+						return emQualquerNome(nomes, func(nome string) error { return s.DemitirColaborador(nome, id) })
+					},
+				)
+			}()
+		}
+		// Renames em número par: o departamento termina com o nome original.
+		trabalho.Add(1)
+		go func() {
+			defer trabalho.Done()
+			for i := 0; i < 100; i++ {
+				if err := s.Renomear(nomes[i%2], nomes[(i+1)%2]); err != nil {
+					t.Errorf("renomear: %v", err)
+					return
+				}
+			}
+		}()
+		trabalho.Wait()
+		parar()
+		if t.Failed() {
+			return
+		}
+
+		presentes := conferirColaboradores(t, s, map[string]int{
+			nomes[0]:      trabalhadoresPorDepto * adicoesPorTrabalhador / 2,
+			"Conferencia": 0,
+		})
+		conferirRegistro(t, s, gravados, presentes, "Conferencia")
+	})
+
+	t.Run("RemoverDuranteAdicoes", func(t *testing.T) {
+		s := novoStore(t, "Temporario", "Conferencia")
+		gravados := &emitidos{}
+		parar := lerDuranteOTeste(s, "Temporario")
+
+		var trabalho sync.WaitGroup
+		for w := 0; w < trabalhadoresPorDepto; w++ {
+			trabalho.Add(1)
+			go func() {
+				defer trabalho.Done()
+				for i := 0; i < adicoesPorTrabalhador; i++ {
+					c, err := s.AdicionarColaborador("Temporario", novoEfetivo(0))
+					if errors.Is(err, ErrDepartamentoNaoEncontrado) {
+						return
+					}
+					if err != nil {
+						t.Errorf("adicionar em Temporario: %v", err)
+						return
+					}
+					gravados.registrar(c.GetId())
+				}
+			}()
+		}
+		trabalho.Add(1)
+		go func() {
+			defer trabalho.Done()
+			if _, err := s.Remover("Temporario", true); err != nil {
+				t.Errorf("remover Temporario: %v", err)
+			}
+		}()
+		trabalho.Wait()
+		parar()
+		if t.Failed() {
+			return
+		}
+
+		// Quem foi gravado antes da remoção teve o ID liberado junto com o departamento.
+		presentes := conferirColaboradores(t, s, map[string]int{"Conferencia": 0})
+		conferirRegistro(t, s, gravados, presentes, "Conferencia")
+	})
+}